@@ -27,7 +27,9 @@ function parseCommandLineArgs(): {
   contextFile?: string;
 } {
   const args = process.argv.slice(2);
-  let contextFile: string | undefined;
+  // CONTEXT_FILE lets the TUI select a context file through `pnpm start`,
+  // which does not forward arguments.
+  let contextFile: string | undefined = process.env.CONTEXT_FILE || undefined;
 
   // Parse arguments
   for (let i = 0; i < args.length; i++) {
@@ -165,12 +167,19 @@ async function runBenchmark() {
       }
 
       if (debugTest) {
-        const matchingTest = allTests.find((test) => test.name === debugTest);
-        if (matchingTest) {
-          testDefinitions = [matchingTest];
-          log(`👉 Selected test: ${matchingTest.name}`);
+        // DEBUG_TEST may list several tests separated by commas
+        const requested = debugTest.split(",").map((name) => name.trim()).filter(Boolean);
+        const matchingTests = allTests.filter((test) => requested.includes(test.name));
+        for (const name of requested) {
+          if (!matchingTests.some((test) => test.name === name)) {
+            console.warn(`⚠️ Test "${name}" not found`);
+          }
+        }
+        if (matchingTests.length > 0) {
+          testDefinitions = matchingTests;
+          log(`👉 Selected tests: ${matchingTests.map((test) => test.name).join(", ")}`);
         } else {
-          console.warn(`⚠️ Test "${debugTest}" not found, using all tests`);
+          console.warn(`⚠️ No requested test was found, using all tests`);
           testDefinitions = undefined; // Use all tests
         }
       } else {
@@ -199,6 +208,8 @@ async function runBenchmark() {
     );
 
     const allResults: HumanEvalResult[] = [];
+    // Every results file written by this run, reported to the TUI on completion
+    const savedResultFiles: string[] = [];
 
     if (madmax) {
       log(
@@ -217,7 +228,9 @@ async function runBenchmark() {
           );
 
           if (results.length > 0) {
-            await saveBenchmarkResults(results, contextFile, contextContent, undefined);
+            savedResultFiles.push(
+              await saveBenchmarkResults(results, contextFile, contextContent, undefined)
+            );
           }
           return results;
         } catch (error) {
@@ -263,7 +276,9 @@ async function runBenchmark() {
           // Save individual model results immediately to prevent loss if later models fail
           if (results.length > 0) {
             try {
-              await saveBenchmarkResults(results, contextFile, contextContent, undefined);
+              savedResultFiles.push(
+                await saveBenchmarkResults(results, contextFile, contextContent, undefined)
+              );
               log(`💾 Saved individual results for ${providerWithModel.modelId}`);
             } catch (saveError) {
               console.error(`⚠️  Failed to save individual results for ${providerWithModel.modelId}:`, saveError);
@@ -327,7 +342,9 @@ async function runBenchmark() {
           // Save individual model results immediately to prevent loss if later models fail
           if (results.length > 0) {
             try {
-              await saveBenchmarkResults(results, contextFile, contextContent, undefined);
+              savedResultFiles.push(
+                await saveBenchmarkResults(results, contextFile, contextContent, undefined)
+              );
               log(`💾 Saved individual results for ${providerWithModel.modelId}`);
             } catch (saveError) {
               console.error(`⚠️  Failed to save individual results for ${providerWithModel.modelId}:`, saveError);
@@ -393,7 +410,7 @@ async function runBenchmark() {
 
     // Emit complete event for TUI
     if (isTUIMode()) {
-      emitComplete("benchmark-complete");
+      emitComplete(savedResultFiles);
     }
 
     // Note: We no longer clean sample directories at the end - they're preserved for inspection
@@ -25,6 +25,7 @@ export interface TUIEvent {
   passAtOne?: number;
   passAtTen?: number;
   resultsSaved?: string;
+  resultsFiles?: string[];
 }
 
 /**
@@ -138,11 +139,13 @@ export function emitError(testName: string, error: string): void {
 }
 
 /**
- * Emit completion event
+ * Emit completion event with the results files the run saved. resultsSaved
+ * names the last file written; resultsFiles lists one file per model.
  */
-export function emitComplete(resultsSaved: string): void {
+export function emitComplete(resultsFiles: string[]): void {
   emitTUIEvent({
     type: 'complete',
-    resultsSaved,
+    resultsSaved: resultsFiles[resultsFiles.length - 1] ?? '',
+    resultsFiles,
   });
 }
@@ -0,0 +1,483 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/report"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
+	"text/tabwriter"
+	"time"
+)
+
+// Exit codes shared by the subcommands.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+	// exitRegression is returned by check-regression when a test regressed,
+	// so CI can tell a failed gate from a broken invocation.
+	exitRegression = 3
+	// exitInvalid is returned by results verify when a file has problems.
+	exitInvalid = 4
+)
+
+// commands are the non-interactive subcommands, run as `tui <name> [args]`.
+var commands = map[string]func(args []string, stdout, stderr io.Writer) int{
+	"export": runExport,
+	"junit":  runJUnit,
+
+	"check-regression": runCheckRegression,
+	"credentials":      runCredentials,
+	"results":          runResults,
+}
+
+// resultsCommands manage the stored results files, run as
+// `tui results <name> [args]`.
+var resultsCommands = map[string]func(args []string, stdout, stderr io.Writer) int{
+	"prune":  runPrune,
+	"verify": runVerify,
+}
+
+// runCommand runs the subcommand named by args[0]. ok is false when args do
+// not name a subcommand and the TUI should start instead.
+func runCommand(args []string, stdout, stderr io.Writer) (code int, ok bool) {
+	if len(args) == 0 {
+		return exitOK, false
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		return exitOK, false
+	}
+	return command(args[1:], stdout, stderr), true
+}
+
+func runExport(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	formatName := flags.String("format", "", "output format: csv, md or json (default: from -o, else csv)")
+	output := flags.String("o", "", "output file (default: stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: tui export [-format csv|md|json] [-o file] <results.json>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	format := report.FormatCSV
+	if *formatName != "" {
+		parsed, err := report.ParseFormat(*formatName)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+		format = parsed
+	} else if inferred, ok := report.FormatForPath(*output); ok {
+		format = inferred
+	}
+
+	entries, err := loadResultsFiles("export", flags.Args(), stderr)
+	if err != nil {
+		return exitError
+	}
+
+	rows := report.Rows(entries)
+	if *output == "" {
+		if err := report.Write(stdout, format, rows); err != nil {
+			fmt.Fprintf(stderr, "export: %v\n", err)
+			return exitError
+		}
+		return exitOK
+	}
+	if err := report.WriteFile(*output, format, rows); err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return exitError
+	}
+	fmt.Fprintf(stderr, "Wrote %d rows to %s\n", len(rows), *output)
+	return exitOK
+}
+
+func runJUnit(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("junit", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	threshold := flags.Float64("threshold", report.DefaultJUnitThreshold, "pass@1 below which a test case fails (0-1)")
+	output := flags.String("o", "", "output file (default: stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: tui junit [-threshold 0.5] [-o file] <results.json>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return exitUsage
+	}
+	if *threshold < 0 || *threshold > 1 {
+		fmt.Fprintf(stderr, "junit: threshold %v is outside 0..1\n", *threshold)
+		return exitUsage
+	}
+
+	entries, err := loadResultsFiles("junit", flags.Args(), stderr)
+	if err != nil {
+		return exitError
+	}
+
+	junit := report.BuildJUnit(entries, *threshold, nil)
+	if *output == "" {
+		err = report.WriteJUnit(stdout, junit)
+	} else {
+		err = report.WriteJUnitFile(*output, junit)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "junit: %v\n", err)
+		return exitError
+	}
+	if *output != "" {
+		fmt.Fprintf(stderr, "Wrote %d test cases (%d failing) to %s\n", junit.Tests, junit.Failures, *output)
+	}
+	return exitOK
+}
+
+func runCheckRegression(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("check-regression", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	margin := flags.Float64("margin", analysis.DefaultRegressionMargin, "pass@1 drop (0-1) that fails the check even when not significant")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: tui check-regression [-margin 0.1] <new.json> <baseline.json>")
+		fmt.Fprintf(stderr, "Exits %d when a test regressed, %d on errors.\n", exitRegression, exitError)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return exitUsage
+	}
+	if *margin < 0 || *margin > 1 {
+		fmt.Fprintf(stderr, "check-regression: margin %v is outside 0..1\n", *margin)
+		return exitUsage
+	}
+
+	candidate, err := loadResultsFiles("check-regression", flags.Args()[:1], stderr)
+	if err != nil {
+		return exitError
+	}
+	baseline, err := loadResultsFiles("check-regression", flags.Args()[1:], stderr)
+	if err != nil {
+		return exitError
+	}
+
+	if warning := bridge.SuiteWarning(flags.Args()); warning != "" {
+		fmt.Fprintf(stderr, "check-regression: warning: %s\n", warning)
+	}
+
+	checks := analysis.CheckRegressions(baseline, candidate, *margin)
+	if len(checks) == 0 {
+		fmt.Fprintln(stderr, "check-regression: the files share no model and test to compare")
+		return exitError
+	}
+
+	table := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "STATUS\tMODEL\tTEST\tBASELINE\tNEW\tDELTA\tP")
+	for _, check := range checks {
+		status := "ok"
+		if check.Regressed() {
+			status = "REGRESSED"
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%.1f%% (%d)\t%.1f%% (%d)\t%+.1f\t%.3f\n",
+			status, check.Model, check.TestName,
+			check.Baseline.PassAtOne()*100, check.Baseline.Samples,
+			check.Candidate.PassAtOne()*100, check.Candidate.Samples,
+			check.Delta*100, check.PValue)
+	}
+	if err := table.Flush(); err != nil {
+		fmt.Fprintf(stderr, "check-regression: %v\n", err)
+		return exitError
+	}
+
+	regressions := analysis.Regressions(checks)
+	if len(regressions) > 0 {
+		fmt.Fprintf(stdout, "\n%d of %d tests regressed (margin %.1f points or p < %.2f)\n", len(regressions), len(checks), *margin*100, stats.SignificanceLevel)
+		return exitRegression
+	}
+	fmt.Fprintf(stdout, "\nNo regressions in %d tests\n", len(checks))
+	return exitOK
+}
+
+func runResults(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		if command, ok := resultsCommands[args[0]]; ok {
+			return command(args[1:], stdout, stderr)
+		}
+	}
+	names := make([]string, 0, len(resultsCommands))
+	for name := range resultsCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(stderr, "Usage: tui results <%s> [args]\n", strings.Join(names, "|"))
+	return exitUsage
+}
+
+// protectFlag collects repeated -protect patterns.
+type protectFlag []string
+
+func (p *protectFlag) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *protectFlag) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func runPrune(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("results prune", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "", "benchmarks directory (default: the project's benchmarks/)")
+	olderThan := flags.String("older-than", "", "prune runs older than this age, e.g. 30d or 72h")
+	maxSamples := flags.Int("max-samples", 0, "prune runs with at most this many samples per test")
+	model := flags.String("model", "", "prune runs whose provider or model contains this text")
+	tag := flags.String("tag", "", "prune runs whose file name carries this custom prefix")
+	keepLatest := flags.Int("keep-latest", 1, "always keep the newest N runs of each model")
+	archive := flags.String("archive", "", "bundle pruned runs into this .tar.gz before deleting them")
+	dryRun := flags.Bool("dry-run", false, "show what would be pruned without changing anything")
+	includeV1 := flags.Bool("include-v1", false, "also prune the legacy runs in benchmarks/v1")
+	var protected protectFlag
+	flags.Var(&protected, "protect", "never touch files matching this glob (repeatable)")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: tui results prune [-dry-run] [-archive bundle.tar.gz] [-older-than 30d] [-max-samples 1] [-model text] [-tag name] [-keep-latest 1] [-include-v1]")
+		fmt.Fprintf(stderr, "Baselines and files listed in %s are never touched.\n", bridge.ProtectFileName)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return exitUsage
+	}
+	if *keepLatest < 0 || *maxSamples < 0 {
+		fmt.Fprintln(stderr, "results prune: -keep-latest and -max-samples must not be negative")
+		return exitUsage
+	}
+
+	filter := bridge.PruneFilter{MaxSamples: *maxSamples, Model: *model, Tag: *tag}
+	if *olderThan != "" {
+		age, err := parseAge(*olderThan)
+		if err != nil {
+			fmt.Fprintf(stderr, "results prune: %v\n", err)
+			return exitUsage
+		}
+		filter.Before = time.Now().Add(-age)
+	}
+	if filter.IsEmpty() {
+		fmt.Fprintln(stderr, "results prune: give at least one of -older-than, -max-samples, -model or -tag")
+		return exitUsage
+	}
+
+	if *dir == "" {
+		benchmarksDir, err := bridge.GetBenchmarksDir()
+		if err != nil {
+			fmt.Fprintf(stderr, "results prune: %v\n", err)
+			return exitError
+		}
+		*dir = benchmarksDir
+	}
+	runs, err := bridge.ListBenchmarkRuns(*dir, *includeV1)
+	if err != nil {
+		fmt.Fprintf(stderr, "results prune: %v\n", err)
+		return exitError
+	}
+	decisions, err := bridge.PlanPrune(*dir, runs, bridge.PrunePolicy{
+		Filter:     filter,
+		KeepLatest: *keepLatest,
+		Protected:  protected,
+		IncludeV1:  *includeV1,
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "results prune: %v\n", err)
+		return exitError
+	}
+
+	var pruned []bridge.BenchmarkRun
+	var size int64
+	table := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ACTION\tFILE\tDATE\tMODELS\tSAMPLES\tSIZE")
+	for _, decision := range decisions {
+		action := "prune"
+		if !decision.Prune {
+			action = "keep (" + decision.Reason + ")"
+		}
+		var fileSize int64
+		if info, err := os.Stat(decision.Run.Path); err == nil {
+			fileSize = info.Size()
+		}
+		if decision.Prune {
+			pruned = append(pruned, decision.Run)
+			size += fileSize
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			action, decision.Run.Name(), decision.Run.Date.Local().Format("2006-01-02 15:04"),
+			strings.Join(decision.Run.Models, ","), decision.Run.Samples, formatBytes(fileSize))
+	}
+	if err := table.Flush(); err != nil {
+		fmt.Fprintf(stderr, "results prune: %v\n", err)
+		return exitError
+	}
+
+	verb, done := "delete", "Deleted"
+	if *archive != "" {
+		verb, done = "archive and delete", "Archived and deleted"
+	}
+	switch {
+	case len(pruned) == 0:
+		fmt.Fprintf(stdout, "\nNothing to prune: %d matching runs are kept\n", len(decisions))
+		return exitOK
+	case *dryRun:
+		fmt.Fprintf(stdout, "\nDry run: would %s %d of %d runs (%s); %d matching runs are kept\n",
+			verb, len(pruned), len(runs), formatBytes(size), len(decisions)-len(pruned))
+		return exitOK
+	}
+
+	if *archive != "" {
+		if err := bridge.ArchiveRuns(*archive, *dir, pruned); err != nil {
+			fmt.Fprintf(stderr, "results prune: archiving: %v (nothing was deleted)\n", err)
+			return exitError
+		}
+	}
+	if err := bridge.DeleteRuns(pruned); err != nil {
+		fmt.Fprintf(stderr, "results prune: %v\n", err)
+		return exitError
+	}
+	fmt.Fprintf(stdout, "\n%s %d of %d runs (%s)", done, len(pruned), len(runs), formatBytes(size))
+	if *archive != "" {
+		fmt.Fprintf(stdout, " into %s", *archive)
+	}
+	fmt.Fprintln(stdout)
+	return exitOK
+}
+
+func runVerify(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("results verify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "", "benchmarks directory to check when no files are given (default: the project's benchmarks/)")
+	strict := flags.Bool("strict", false, "fail on warnings too")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: tui results verify [-strict] [-dir benchmarks] [results.json...]")
+		fmt.Fprintf(stderr, "Exits %d when a file has errors (or warnings with -strict), %d when files cannot be read.\n", exitInvalid, exitError)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		if *dir == "" {
+			benchmarksDir, err := bridge.GetBenchmarksDir()
+			if err != nil {
+				fmt.Fprintf(stderr, "results verify: %v\n", err)
+				return exitError
+			}
+			*dir = benchmarksDir
+		}
+		files, err := results.ListFiles(*dir, true)
+		if err != nil {
+			fmt.Fprintf(stderr, "results verify: %v\n", err)
+			return exitError
+		}
+		paths = files
+	}
+
+	var problems []results.Problem
+	failedFiles := 0
+	for _, path := range paths {
+		found, err := results.VerifyFile(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "results verify: %v\n", err)
+			return exitError
+		}
+		if len(found) > 0 {
+			failedFiles++
+		}
+		for _, problem := range found {
+			fmt.Fprintf(stdout, "%s: %s: %s: %s\n", path, problem.JSONPath, problem.Severity, problem.Message)
+		}
+		problems = append(problems, found...)
+	}
+
+	errorCount, warningCount := results.CountProblems(problems)
+	if len(problems) > 0 {
+		fmt.Fprintln(stdout)
+	}
+	fmt.Fprintf(stdout, "Checked %d files: %d errors, %d warnings in %d files\n", len(paths), errorCount, warningCount, failedFiles)
+	if errorCount > 0 || (*strict && warningCount > 0) {
+		return exitInvalid
+	}
+	return exitOK
+}
+
+// parseAge reads a duration such as 72h, or a number of days such as 30d.
+func parseAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		count, err := strconv.Atoi(days)
+		if err != nil || count < 0 {
+			return 0, fmt.Errorf("invalid age %q: want days like 30d or a duration like 72h", value)
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q: want days like 30d or a duration like 72h", value)
+	}
+	return age, nil
+}
+
+// formatBytes renders a file size with a binary unit.
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value, suffix := float64(size)/unit, "KiB"
+	for _, next := range []string{"MiB", "GiB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
+
+// loadResultsFiles reads the entries of every results file, reporting
+// malformed entries as skipped and stopping at the first unreadable file.
+func loadResultsFiles(command string, paths []string, stderr io.Writer) ([]results.Entry, error) {
+	var entries []results.Entry
+	for _, path := range paths {
+		file, err := results.Load(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", command, err)
+			return nil, err
+		}
+		for _, issue := range file.Issues {
+			fmt.Fprintf(stderr, "%s: skipping %v\n", command, issue)
+		}
+		entries = append(entries, file.Entries...)
+	}
+	return entries, nil
+}
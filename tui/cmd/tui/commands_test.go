@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const validEntry = `{"testName":"counter","provider":"OpenAI","modelId":"gpt-4o","numSamples":2,"numCorrect":1,"pass1":0.5,"pass10":1,
+	"context":{"used":false,"content":""},"timestamp":"2025-10-17T19:39:14.181Z",
+	"samples":[{"index":0,"code":"<p>ok</p>","success":true,"errors":[]},
+	           {"index":1,"code":"<p>","success":false,"errors":["Expected 1 to be 2"]}]}`
+
+// commandCase runs one command line and checks its exit code and output.
+type commandCase struct {
+	name   string
+	args   []string
+	code   int
+	stdout string
+	stderr string
+}
+
+func (c commandCase) run(t *testing.T) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code, ok := runCommand(c.args, &stdout, &stderr)
+	if !ok {
+		t.Fatalf("%s: %v did not run a command", c.name, c.args)
+	}
+	if code != c.code {
+		t.Errorf("%s: exit code %d, want %d\nstdout:\n%s\nstderr:\n%s", c.name, code, c.code, stdout.String(), stderr.String())
+	}
+	if !strings.Contains(stdout.String(), c.stdout) {
+		t.Errorf("%s: stdout does not contain %q:\n%s", c.name, c.stdout, stdout.String())
+	}
+	if !strings.Contains(stderr.String(), c.stderr) {
+		t.Errorf("%s: stderr does not contain %q:\n%s", c.name, c.stderr, stderr.String())
+	}
+}
+
+// resultsFileName names a results file saved at date.
+func resultsFileName(date time.Time) string {
+	return "benchmark-results-" + date.UTC().Format("2006-01-02T15-04-05.000Z") + ".json"
+}
+
+// writeEntries saves entries, given as JSON objects, as a results file.
+func writeEntries(t *testing.T, dir, name string, entries ...string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("["+strings.Join(entries, ",")+"]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunCommandLeavesOtherArgumentsToTheTUI(t *testing.T) {
+	for _, args := range [][]string{nil, {"--profile", "nightly"}, {"prune"}} {
+		if _, ok := runCommand(args, &bytes.Buffer{}, &bytes.Buffer{}); ok {
+			t.Errorf("%v ran a command, want the TUI", args)
+		}
+	}
+}
+
+func TestExportAndJUnitCommands(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEntries(t, dir, resultsFileName(time.Now()), validEntry)
+	missing := filepath.Join(dir, "missing.json")
+
+	for _, c := range []commandCase{
+		{name: "export csv", args: []string{"export", path}, code: exitOK, stdout: "gpt-4o"},
+		{name: "export md", args: []string{"export", "-format", "md", path}, code: exitOK, stdout: "| counter"},
+		{name: "export to file", args: []string{"export", "-o", filepath.Join(dir, "summary.json"), path}, code: exitOK, stderr: "Wrote 1 rows"},
+		{name: "export unknown format", args: []string{"export", "-format", "xml", path}, code: exitUsage, stderr: `unknown export format "xml"`},
+		{name: "export no files", args: []string{"export"}, code: exitUsage, stderr: "Usage: tui export"},
+		{name: "export unknown flag", args: []string{"export", "-pretty", path}, code: exitUsage, stderr: "flag provided but not defined"},
+		{name: "export missing file", args: []string{"export", missing}, code: exitError, stderr: "export:"},
+
+		{name: "junit", args: []string{"junit", path}, code: exitOK, stdout: "<testsuites"},
+		{name: "junit to file", args: []string{"junit", "-threshold", "0.8", "-o", filepath.Join(dir, "report.xml"), path}, code: exitOK, stderr: "Wrote 1 test cases (1 failing)"},
+		{name: "junit threshold out of range", args: []string{"junit", "-threshold", "1.5", path}, code: exitUsage, stderr: "threshold 1.5 is outside 0..1"},
+		{name: "junit threshold not a number", args: []string{"junit", "-threshold", "half", path}, code: exitUsage, stderr: "invalid value"},
+		{name: "junit no files", args: []string{"junit"}, code: exitUsage, stderr: "Usage: tui junit"},
+		{name: "junit missing file", args: []string{"junit", missing}, code: exitError, stderr: "junit:"},
+	} {
+		c.run(t)
+	}
+}
+
+func TestVerifyCommand(t *testing.T) {
+	dir := t.TempDir()
+	valid := writeEntries(t, dir, resultsFileName(time.Now().Add(-time.Hour)), validEntry)
+	gap := writeEntries(t, dir, resultsFileName(time.Now()), strings.Replace(validEntry, `"index":1`, `"index":3`, 1))
+	wrong := writeEntries(t, t.TempDir(), "wrong.json", strings.Replace(validEntry, `"numCorrect":1`, `"numCorrect":2`, 1))
+
+	for _, c := range []commandCase{
+		{name: "valid", args: []string{"results", "verify", valid}, code: exitOK, stdout: "Checked 1 files: 0 errors, 0 warnings"},
+		{name: "valid strict", args: []string{"results", "verify", "-strict", valid}, code: exitOK},
+		{name: "index gap", args: []string{"results", "verify", gap}, code: exitOK, stdout: "warning"},
+		{name: "index gap strict", args: []string{"results", "verify", "-strict", gap}, code: exitInvalid, stdout: "0 errors, 1 warnings"},
+		{name: "directory strict", args: []string{"results", "verify", "-strict", "-dir", dir}, code: exitInvalid, stdout: "Checked 2 files"},
+		{name: "wrong counts", args: []string{"results", "verify", wrong}, code: exitInvalid, stdout: "error"},
+		{name: "missing file", args: []string{"results", "verify", filepath.Join(dir, "missing.json")}, code: exitError, stderr: "results verify:"},
+		{name: "unknown flag", args: []string{"results", "verify", "-fix"}, code: exitUsage, stderr: "Usage: tui results verify"},
+		{name: "unknown subcommand", args: []string{"results", "check"}, code: exitUsage, stderr: "Usage: tui results <prune|verify>"},
+	} {
+		c.run(t)
+	}
+}
+
+func TestPruneCommand(t *testing.T) {
+	for _, test := range []struct {
+		commandCase
+		// pruned reports whether the old run is deleted.
+		pruned bool
+	}{
+		{commandCase: commandCase{name: "dry run", args: []string{"-dry-run", "-older-than", "30d"}, code: exitOK, stdout: "Dry run: would delete 1 of 2 runs"}},
+		{commandCase: commandCase{name: "delete", args: []string{"-older-than", "30d"}, code: exitOK, stdout: "Deleted 1 of 2 runs"}, pruned: true},
+		{commandCase: commandCase{name: "keep latest", args: []string{"-older-than", "30d", "-keep-latest", "2"}, code: exitOK, stdout: "Nothing to prune"}},
+		{commandCase: commandCase{name: "model filter", args: []string{"-dry-run", "-model", "claude"}, code: exitOK, stdout: "Nothing to prune: 0 matching runs"}},
+		{commandCase: commandCase{name: "no filter", args: []string{"-dry-run"}, code: exitUsage, stderr: "give at least one of"}},
+		{commandCase: commandCase{name: "bad age", args: []string{"-older-than", "soon"}, code: exitUsage, stderr: `invalid age "soon"`}},
+		{commandCase: commandCase{name: "negative samples", args: []string{"-max-samples", "-1"}, code: exitUsage, stderr: "must not be negative"}},
+		{commandCase: commandCase{name: "extra argument", args: []string{"-older-than", "30d", "old.json"}, code: exitUsage, stderr: "Usage: tui results prune"}},
+		{commandCase: commandCase{name: "unknown flag", args: []string{"-force"}, code: exitUsage, stderr: "flag provided but not defined"}},
+	} {
+		dir := t.TempDir()
+		old := writeEntries(t, dir, resultsFileName(time.Now().AddDate(0, -3, 0)), validEntry)
+		writeEntries(t, dir, resultsFileName(time.Now()), validEntry)
+
+		test.args = append([]string{"results", "prune", "-dir", dir}, test.args...)
+		test.run(t)
+		if _, err := os.Stat(old); os.IsNotExist(err) != test.pruned {
+			t.Errorf("%s: old run deleted = %v, want %v", test.name, os.IsNotExist(err), test.pruned)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/config"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// unlockAttempts is how often the passphrase is asked for before giving up.
+const unlockAttempts = 3
+
+// stdinLines reads passphrases piped to stdin, shared between prompts so
+// buffered input is not lost.
+var stdinLines = bufio.NewReader(os.Stdin)
+
+// credentialsCommands manage the encrypted credential store, run as
+// `tui credentials <name> [args]`.
+var credentialsCommands = map[string]func(args []string, stdout, stderr io.Writer) int{
+	"migrate": runMigrateCredentials,
+}
+
+func runCredentials(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		if command, ok := credentialsCommands[args[0]]; ok {
+			return command(args[1:], stdout, stderr)
+		}
+	}
+	names := make([]string, 0, len(credentialsCommands))
+	for name := range credentialsCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(stderr, "Usage: tui credentials <%s> [args]\n", strings.Join(names, "|"))
+	return exitUsage
+}
+
+func runMigrateCredentials(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("credentials migrate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	keepEnv := flags.Bool("keep-env", false, "copy the keys without removing them from .env")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: tui credentials migrate [-keep-env]")
+		fmt.Fprintln(stderr, "Moves the API keys from .env into the encrypted credential store.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	path, err := config.CredentialsFilePath()
+	if err != nil {
+		fmt.Fprintf(stderr, "credentials migrate: %v\n", err)
+		return exitError
+	}
+	var store *config.CredentialStore
+	if config.CredentialStoreExists(path) {
+		store, err = unlockCredentialStore(path, stderr)
+	} else {
+		store, err = createCredentialStore(path, stderr)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "credentials migrate: %v\n", err)
+		return exitError
+	}
+
+	if env, err := config.LoadFromEnv(); err == nil {
+		for _, warning := range env.EnvWarnings {
+			fmt.Fprintf(stderr, "credentials migrate: warning: %s; the line is left as it is\n", warning)
+		}
+	}
+	moved, err := config.MigrateToCredentials(store, *keepEnv)
+	if err != nil {
+		fmt.Fprintf(stderr, "credentials migrate: %v\n", err)
+		return exitError
+	}
+	if len(moved) == 0 {
+		fmt.Fprintln(stdout, "No API keys in .env to migrate")
+		return exitOK
+	}
+	verb := "Moved"
+	if *keepEnv {
+		verb = "Copied"
+	}
+	fmt.Fprintf(stdout, "%s %d keys to %s: %s\n", verb, len(moved), store.Path(), strings.Join(moved, ", "))
+	return exitOK
+}
+
+// loadCredentials unlocks the credential store for the session when one
+// exists, so its keys take precedence over .env.
+func loadCredentials(cfg *config.Config) error {
+	path, err := config.CredentialsFilePath()
+	if err != nil || !config.CredentialStoreExists(path) {
+		return nil
+	}
+	store, err := unlockCredentialStore(path, os.Stderr)
+	if err != nil {
+		return err
+	}
+	cfg.UseCredentials(store)
+	return nil
+}
+
+// unlockCredentialStore opens the store at path with TUI_CREDENTIALS_PASSPHRASE,
+// or by asking for the passphrase up to unlockAttempts times.
+func unlockCredentialStore(path string, prompt io.Writer) (*config.CredentialStore, error) {
+	if passphrase := os.Getenv(config.PassphraseEnv); passphrase != "" {
+		return config.OpenCredentialStore(path, passphrase)
+	}
+	for attempt := 1; ; attempt++ {
+		passphrase, err := readPassphrase(prompt, "Credential store passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		store, err := config.OpenCredentialStore(path, passphrase)
+		if !errors.Is(err, config.ErrWrongPassphrase) || attempt == unlockAttempts {
+			return store, err
+		}
+		fmt.Fprintln(prompt, "Wrong passphrase, try again.")
+	}
+}
+
+// createCredentialStore sets up a new store at path, asking for the
+// passphrase twice unless TUI_CREDENTIALS_PASSPHRASE is set.
+func createCredentialStore(path string, prompt io.Writer) (*config.CredentialStore, error) {
+	passphrase := os.Getenv(config.PassphraseEnv)
+	if passphrase == "" {
+		fmt.Fprintf(prompt, "Creating a credential store at %s\n", path)
+		var err error
+		if passphrase, err = readPassphrase(prompt, "New passphrase: "); err != nil {
+			return nil, err
+		}
+		confirm, err := readPassphrase(prompt, "Repeat passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if confirm != passphrase {
+			return nil, errors.New("the passphrases do not match")
+		}
+	}
+	return config.CreateCredentialStore(path, passphrase)
+}
+
+// readPassphrase reads a line from stdin without echoing it when stdin is a
+// terminal.
+func readPassphrase(prompt io.Writer, label string) (string, error) {
+	fmt.Fprint(prompt, label)
+	if term.IsTerminal(os.Stdin.Fd()) {
+		passphrase, err := term.ReadPassword(os.Stdin.Fd())
+		fmt.Fprintln(prompt)
+		return string(passphrase), err
+	}
+	line, err := stdinLines.ReadString('\n')
+	fmt.Fprintln(prompt)
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,15 +13,56 @@ import (
 )
 
 func main() {
+	if code, ok := runCommand(os.Args[1:], os.Stdout, os.Stderr); ok {
+		os.Exit(code)
+	}
+
+	// Parse flags first so a usage error does not ask for a passphrase.
+	flags := flag.NewFlagSet("tui", flag.ContinueOnError)
+	profileName := flags.String("profile", "", "start with a profile from the TUI config file")
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		os.Exit(exitUsage)
+	}
+
 	// Load existing config
 	cfg, err := config.LoadFromEnv()
 	if err != nil {
 		fmt.Printf("Error loading config: %v\n", err)
 		os.Exit(1)
 	}
+	if err := loadCredentials(cfg); err != nil {
+		fmt.Printf("Error unlocking credentials: %v\n", err)
+		os.Exit(1)
+	}
 
-	// Create initial model
-	initialModel := models.NewProviderModelSelectFromConfig(cfg)
+	// Create initial model: the profile asked for, the welcome screen when
+	// profiles exist, or provider selection.
+	var initialModel tea.Model
+	settings, settingsErr := models.LoadSettings()
+	switch {
+	case *profileName != "":
+		if settingsErr != nil {
+			fmt.Printf("Error loading profiles: %v\n", settingsErr)
+			os.Exit(1)
+		}
+		profile, ok := settings.Profile(*profileName)
+		if !ok {
+			fmt.Printf("Unknown profile %q in %s\n", *profileName, settings.Path)
+			os.Exit(exitUsage)
+		}
+		// The program runs the model's Init itself.
+		initialModel, _, err = models.StartProfile(&models.SharedState{Config: cfg}, profile)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+	case settingsErr != nil:
+		initialModel = models.NewWelcomeModel(cfg)
+	case len(settings.Profiles) > 0:
+		initialModel = models.NewWelcomeModelWithSettings(cfg, settings)
+	default:
+		initialModel = models.NewProviderModelSelectFromConfig(cfg)
+	}
 
 	// Create program with signal handling
 	p := tea.NewProgram(initialModel)
@@ -0,0 +1,174 @@
+// Package analysis derives comparisons and summaries from stored benchmark
+// results.
+package analysis
+
+import (
+	"sort"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
+)
+
+// Outcome is the pooled result of one test category within one run.
+type Outcome struct {
+	Present bool
+	Correct int
+	Samples int
+}
+
+// PassAtOne returns the pass@1 estimate, which for pooled samples is the
+// fraction of correct samples.
+func (o Outcome) PassAtOne() float64 {
+	if o.Samples == 0 {
+		return 0
+	}
+	return float64(o.Correct) / float64(o.Samples)
+}
+
+// SampleFlip records a sample index whose outcome changed between two runs
+// of the same model.
+type SampleFlip struct {
+	Model  string
+	Index  int
+	Before bool
+	After  bool
+}
+
+// ComparisonRow compares one test category across two runs.
+type ComparisonRow struct {
+	TestName    string
+	Base        Outcome
+	Candidate   Outcome
+	Delta       float64
+	PValue      float64
+	Significant bool
+	Flips       []SampleFlip
+}
+
+// Comparison is the per-test difference between a base and a candidate run.
+type Comparison struct {
+	Rows      []ComparisonRow
+	SameModel bool
+}
+
+// Compare pools each run's entries per test category and tests every change
+// in pass@1 with Fisher's exact test. When both runs cover the same models,
+// samples are also matched by index to list the ones that flipped.
+func Compare(base, candidate []results.Entry) Comparison {
+	baseOutcomes, baseOrder := pooledOutcomes(base)
+	candidateOutcomes, candidateOrder := pooledOutcomes(candidate)
+
+	comparison := Comparison{SameModel: sameModels(base, candidate)}
+	for _, name := range mergeOrder(baseOrder, candidateOrder) {
+		row := ComparisonRow{
+			TestName:  name,
+			Base:      baseOutcomes[name],
+			Candidate: candidateOutcomes[name],
+			PValue:    1,
+		}
+		if row.Base.Present && row.Candidate.Present {
+			row.Delta = row.Candidate.PassAtOne() - row.Base.PassAtOne()
+			row.PValue = stats.FisherExact(row.Base.Correct, row.Base.Samples, row.Candidate.Correct, row.Candidate.Samples)
+			row.Significant = row.PValue < stats.SignificanceLevel
+		}
+		if comparison.SameModel {
+			row.Flips = sampleFlips(base, candidate, name)
+		}
+		comparison.Rows = append(comparison.Rows, row)
+	}
+	return comparison
+}
+
+func pooledOutcomes(entries []results.Entry) (map[string]Outcome, []string) {
+	outcomes := make(map[string]Outcome)
+	order := make([]string, 0)
+	for _, entry := range entries {
+		outcome, ok := outcomes[entry.TestName]
+		if !ok {
+			order = append(order, entry.TestName)
+		}
+		outcome.Present = true
+		outcome.Correct += entry.NumCorrect
+		outcome.Samples += entry.NumSamples
+		outcomes[entry.TestName] = outcome
+	}
+	return outcomes, order
+}
+
+func mergeOrder(first, second []string) []string {
+	seen := make(map[string]bool, len(first)+len(second))
+	merged := make([]string, 0, len(first)+len(second))
+	for _, names := range [][]string{first, second} {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				merged = append(merged, name)
+			}
+		}
+	}
+	return merged
+}
+
+// ModelIDs returns the distinct model IDs of entries in first-seen order.
+func ModelIDs(entries []results.Entry) []string {
+	seen := make(map[string]bool)
+	models := make([]string, 0)
+	for _, entry := range entries {
+		if !seen[entry.ModelID] {
+			seen[entry.ModelID] = true
+			models = append(models, entry.ModelID)
+		}
+	}
+	return models
+}
+
+func sameModels(base, candidate []results.Entry) bool {
+	left, right := ModelIDs(base), ModelIDs(candidate)
+	if len(left) == 0 || len(left) != len(right) {
+		return false
+	}
+	sort.Strings(left)
+	sort.Strings(right)
+	for i := range left {
+		if left[i] != right[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sampleFlips(base, candidate []results.Entry, testName string) []SampleFlip {
+	before := sampleOutcomes(base, testName)
+	after := sampleOutcomes(candidate, testName)
+
+	var flips []SampleFlip
+	for _, model := range ModelIDs(base) {
+		for index, passed := range before[model] {
+			if afterPassed, ok := after[model][index]; ok && afterPassed != passed {
+				flips = append(flips, SampleFlip{Model: model, Index: index, Before: passed, After: afterPassed})
+			}
+		}
+	}
+	sort.SliceStable(flips, func(i, j int) bool {
+		if flips[i].Model != flips[j].Model {
+			return flips[i].Model < flips[j].Model
+		}
+		return flips[i].Index < flips[j].Index
+	})
+	return flips
+}
+
+func sampleOutcomes(entries []results.Entry, testName string) map[string]map[int]bool {
+	outcomes := make(map[string]map[int]bool)
+	for _, entry := range entries {
+		if entry.TestName != testName {
+			continue
+		}
+		if outcomes[entry.ModelID] == nil {
+			outcomes[entry.ModelID] = make(map[int]bool)
+		}
+		for _, sample := range entry.Samples {
+			outcomes[entry.ModelID][sample.Index] = sample.Success
+		}
+	}
+	return outcomes
+}
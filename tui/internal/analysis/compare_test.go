@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func entry(model, test string, outcomes ...bool) results.Entry {
+	e := results.Entry{TestName: test, ModelID: model, NumSamples: len(outcomes)}
+	for i, passed := range outcomes {
+		if passed {
+			e.NumCorrect++
+		}
+		e.Samples = append(e.Samples, results.Sample{Index: i, Success: passed})
+	}
+	return e
+}
+
+func repeat(value bool, n int) []bool {
+	outcomes := make([]bool, n)
+	for i := range outcomes {
+		outcomes[i] = value
+	}
+	return outcomes
+}
+
+func TestCompareFlagsOnlyChangesBeyondNoise(t *testing.T) {
+	base := []results.Entry{
+		entry("gpt-4o", "counter", append(repeat(true, 7), repeat(false, 3)...)...),
+		entry("gpt-4o", "effect", repeat(true, 10)...),
+	}
+	candidate := []results.Entry{
+		entry("gpt-4o", "counter", append(repeat(true, 8), repeat(false, 2)...)...),
+		entry("gpt-4o", "effect", append(repeat(true, 2), repeat(false, 8)...)...),
+		entry("gpt-4o", "snippets", repeat(true, 10)...),
+	}
+
+	comparison := Compare(base, candidate)
+	if len(comparison.Rows) != 3 || !comparison.SameModel {
+		t.Fatalf("unexpected comparison: %#v", comparison)
+	}
+
+	counter := comparison.Rows[0]
+	if math.Abs(counter.Delta-0.1) > 1e-9 || counter.Significant {
+		t.Fatalf("70%% vs 80%% should be within noise, got %#v", counter)
+	}
+	effect := comparison.Rows[1]
+	if math.Abs(effect.Delta+0.8) > 1e-9 || !effect.Significant {
+		t.Fatalf("100%% vs 20%% should be significant, got %#v", effect)
+	}
+	if snippets := comparison.Rows[2]; snippets.Base.Present || snippets.Significant {
+		t.Fatalf("a test missing from one run has no delta, got %#v", snippets)
+	}
+}
+
+func TestCompareListsFlippedSamplesForSameModel(t *testing.T) {
+	base := []results.Entry{entry("gpt-4o", "counter", true, false, true)}
+	candidate := []results.Entry{entry("gpt-4o", "counter", false, true, true)}
+
+	flips := Compare(base, candidate).Rows[0].Flips
+	if len(flips) != 2 {
+		t.Fatalf("expected two flipped samples, got %#v", flips)
+	}
+	if flips[0].Index != 0 || !flips[0].Before || flips[0].After {
+		t.Fatalf("expected sample 0 to flip pass→fail, got %#v", flips[0])
+	}
+	if flips[1].Index != 1 || flips[1].Before || !flips[1].After {
+		t.Fatalf("expected sample 1 to flip fail→pass, got %#v", flips[1])
+	}
+
+	other := []results.Entry{entry("claude-sonnet-4", "counter", false, true, true)}
+	if comparison := Compare(base, other); comparison.SameModel || comparison.Rows[0].Flips != nil {
+		t.Fatal("different models should not report sample flips")
+	}
+}
+
+func TestScoreModelsMarksTiesWithinNoise(t *testing.T) {
+	scores := ScoreModels([]results.Entry{
+		entry("weak", "counter", append(repeat(true, 1), repeat(false, 9)...)...),
+		entry("weak", "effect", repeat(false, 10)...),
+		entry("strong", "counter", repeat(true, 10)...),
+		entry("strong", "effect", append(repeat(true, 9), repeat(false, 1)...)...),
+		entry("close", "counter", repeat(true, 10)...),
+		entry("close", "effect", append(repeat(true, 8), repeat(false, 2)...)...),
+	})
+
+	if len(scores) != 3 || scores[0].Model != "strong" || scores[1].Model != "close" || scores[2].Model != "weak" {
+		t.Fatalf("expected models ranked by overall score, got %#v", scores)
+	}
+	if scores[0].TiedWithPrevious || !scores[1].TiedWithPrevious || scores[2].TiedWithPrevious {
+		t.Fatalf("expected only close to tie with strong, got %v %v %v",
+			scores[0].TiedWithPrevious, scores[1].TiedWithPrevious, scores[2].TiedWithPrevious)
+	}
+	if !scores[1].Interval.Contains(scores[1].Overall) {
+		t.Fatalf("interval %+v should contain the overall score %.2f", scores[1].Interval, scores[1].Overall)
+	}
+}
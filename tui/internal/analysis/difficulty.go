@@ -0,0 +1,149 @@
+package analysis
+
+import (
+	"sort"
+	"svelte-bench/tui/internal/results"
+)
+
+const (
+	// brokenZeroShare is the share of models scoring 0% above which a test is
+	// more likely broken than hard.
+	brokenZeroShare = 0.9
+	// easyMean is the mean pass@1 above which a test no longer separates
+	// models.
+	easyMean = 0.95
+	// weakDiscrimination is the discrimination index below which strong and
+	// weak models score about the same.
+	weakDiscrimination = 0.1
+)
+
+// TestDifficulty summarizes how every model did on one test category.
+type TestDifficulty struct {
+	TestName string
+	Models   int
+	Mean     float64
+	Variance float64
+	// ZeroShare is the fraction of models that scored 0% pass@1.
+	ZeroShare float64
+	// Discrimination is the mean pass@1 of the strongest third of models
+	// minus that of the weakest third, ranking models by their score on the
+	// other tests. Near 1 the test separates strong from weak models; near 0
+	// or below it does not.
+	Discrimination float64
+	// Discriminates is false when too few models ran the test and others to
+	// rank them.
+	Discriminates bool
+}
+
+// Verdict flags tests worth a look from the test suite's maintainers, or
+// returns "" when nothing stands out.
+func (d TestDifficulty) Verdict() string {
+	switch {
+	case d.Models > 1 && d.ZeroShare >= brokenZeroShare:
+		return "almost every model fails: check the test"
+	case d.Mean >= easyMean:
+		return "too easy"
+	case d.Discriminates && d.Discrimination < weakDiscrimination:
+		return "does not separate strong and weak models"
+	}
+	return ""
+}
+
+// DifficultySort selects how test categories are ordered.
+type DifficultySort int
+
+const (
+	// SortByDifficulty puts the lowest mean pass@1 first.
+	SortByDifficulty DifficultySort = iota
+	// SortByDiscrimination puts the best discriminating test first.
+	SortByDiscrimination
+)
+
+func (s DifficultySort) String() string {
+	if s == SortByDiscrimination {
+		return "discrimination"
+	}
+	return "difficulty"
+}
+
+// DifficultyReport ranks the test categories of every stored model.
+type DifficultyReport struct {
+	Models int
+	Tests  []TestDifficulty
+}
+
+// BuildDifficulty scores each test category over the latest result of every
+// provider/model in entries, hardest first.
+func BuildDifficulty(entries []results.Entry) DifficultyReport {
+	board := BuildLeaderboard(entries, LeaderboardLatest)
+	report := DifficultyReport{Models: len(board.Rows)}
+
+	for _, test := range board.Tests {
+		difficulty := TestDifficulty{TestName: test}
+		var scores []float64
+		type ranked struct {
+			score float64
+			rest  float64
+		}
+		var rankedModels []ranked
+		for _, row := range board.Rows {
+			cell, ok := row.Cells[test]
+			if !ok {
+				continue
+			}
+			scores = append(scores, cell.PassAtOne)
+			if cell.PassAtOne == 0 {
+				difficulty.ZeroShare++
+			}
+			if len(row.Cells) > 1 {
+				rest := (row.Overall*float64(len(row.Cells)) - cell.PassAtOne) / float64(len(row.Cells)-1)
+				rankedModels = append(rankedModels, ranked{score: cell.PassAtOne, rest: rest})
+			}
+		}
+		difficulty.Models = len(scores)
+		if difficulty.Models == 0 {
+			continue
+		}
+		difficulty.ZeroShare /= float64(difficulty.Models)
+		for _, score := range scores {
+			difficulty.Mean += score
+		}
+		difficulty.Mean /= float64(len(scores))
+		for _, score := range scores {
+			difficulty.Variance += (score - difficulty.Mean) * (score - difficulty.Mean)
+		}
+		difficulty.Variance /= float64(len(scores))
+
+		if len(rankedModels) >= 3 {
+			sort.SliceStable(rankedModels, func(i, j int) bool {
+				return rankedModels[i].rest > rankedModels[j].rest
+			})
+			group := len(rankedModels) / 3
+			strong, weak := 0.0, 0.0
+			for i := range group {
+				strong += rankedModels[i].score
+				weak += rankedModels[len(rankedModels)-1-i].score
+			}
+			difficulty.Discrimination = (strong - weak) / float64(group)
+			difficulty.Discriminates = true
+		}
+		report.Tests = append(report.Tests, difficulty)
+	}
+
+	report.Sort(SortByDifficulty)
+	return report
+}
+
+// Sort orders the test categories.
+func (r *DifficultyReport) Sort(by DifficultySort) {
+	sort.SliceStable(r.Tests, func(i, j int) bool {
+		left, right := r.Tests[i], r.Tests[j]
+		if by == SortByDiscrimination && left.Discrimination != right.Discrimination {
+			return left.Discrimination > right.Discrimination
+		}
+		if left.Mean != right.Mean {
+			return left.Mean < right.Mean
+		}
+		return left.TestName < right.TestName
+	})
+}
@@ -0,0 +1,58 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func TestBuildDifficultyScoresEachTest(t *testing.T) {
+	scores := map[string][]float64{
+		// Strong models pass, weak ones fail: discriminates perfectly.
+		"props": {1, 1, 0.5, 0, 0, 0},
+		// Everyone passes.
+		"hello-world": {1, 1, 1, 1, 1, 1},
+		// Everyone fails.
+		"inspect": {0, 0, 0, 0, 0, 0},
+		// Used to rank models, strongest first.
+		"counter": {1, 0.9, 0.8, 0.3, 0.2, 0.1},
+	}
+	var entries []results.Entry
+	for test, byModel := range scores {
+		for i, pass1 := range byModel {
+			entries = append(entries, results.Entry{Provider: "OpenAI", ModelID: string(rune('a' + i)), TestName: test, Pass1: pass1})
+		}
+	}
+
+	report := BuildDifficulty(entries)
+	if report.Models != 6 || len(report.Tests) != 4 || report.Tests[0].TestName != "inspect" {
+		t.Fatalf("expected four tests over six models, hardest first, got %#v", report)
+	}
+
+	byName := make(map[string]TestDifficulty)
+	for _, test := range report.Tests {
+		byName[test.TestName] = test
+	}
+	props := byName["props"]
+	if math.Abs(props.Mean-2.5/6) > 1e-9 || math.Abs(props.ZeroShare-0.5) > 1e-9 {
+		t.Fatalf("unexpected props summary %#v", props)
+	}
+	if math.Abs(props.Variance-(2*math.Pow(1-2.5/6, 2)+math.Pow(0.5-2.5/6, 2)+3*math.Pow(2.5/6, 2))/6) > 1e-9 {
+		t.Fatalf("unexpected props variance %v", props.Variance)
+	}
+	if !props.Discriminates || props.Discrimination != 1 || props.Verdict() != "" {
+		t.Fatalf("expected props to separate strong and weak models, got %#v", props)
+	}
+	if verdict := byName["inspect"].Verdict(); verdict != "almost every model fails: check the test" {
+		t.Fatalf("unexpected inspect verdict %q", verdict)
+	}
+	if verdict := byName["hello-world"].Verdict(); verdict != "too easy" {
+		t.Fatalf("unexpected hello-world verdict %q", verdict)
+	}
+
+	report.Sort(SortByDiscrimination)
+	if report.Tests[0].TestName != "props" {
+		t.Fatalf("expected props to discriminate best, got %s", report.Tests[0].TestName)
+	}
+}
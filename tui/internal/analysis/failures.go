@@ -0,0 +1,180 @@
+package analysis
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/results"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// maxPatternLength caps a normalized message; the start names the failure.
+const maxPatternLength = 160
+
+// NoErrorPattern stands in for failed samples that recorded no error.
+const NoErrorPattern = "(no error message recorded)"
+
+var (
+	// filePathPattern matches source paths with optional line and column,
+	// such as tmp/samples/openrouter/Component.svelte:12:2.
+	filePathPattern = regexp.MustCompile(`(?:[A-Za-z]:)?(?:[\w.@$-]*[/\\])+[\w.@$-]+\.(?:svelte|ts|js|mjs|cjs|json)(?::\d+)*|\b[\w.-]+\.(?:svelte|ts|js|mjs|cjs)(?::\d+)+`)
+	urlPattern      = regexp.MustCompile(`https?://\S+`)
+	// quotedPattern matches quoted values. Identifier-like values such as
+	// 'trace' or "book-title" name what failed and are kept.
+	quotedPattern     = regexp.MustCompile(`'[^']*'|"[^"]*"`)
+	identifierLike    = regexp.MustCompile(`^['"][\w$./-]{1,40}['"]$`)
+	numberPattern     = regexp.MustCompile(`[-+]?\b\d+(?:\.\d+)?\b`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeError reduces an error message to the pattern it shares with
+// similar failures: escape codes, paths, line numbers, URLs, values and
+// numbers are replaced and only the first line, which names the failure, is
+// kept.
+func NormalizeError(message string) string {
+	message = ansi.Strip(message)
+	for _, line := range strings.Split(message, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			message = line
+			break
+		}
+	}
+	message = filePathPattern.ReplaceAllString(message, "<file>")
+	message = urlPattern.ReplaceAllString(message, "<url>")
+	message = quotedPattern.ReplaceAllStringFunc(message, func(value string) string {
+		if identifierLike.MatchString(value) {
+			return value
+		}
+		return "<value>"
+	})
+	message = numberPattern.ReplaceAllString(message, "<n>")
+	message = strings.TrimSpace(whitespacePattern.ReplaceAllString(message, " "))
+	if message == "" {
+		return NoErrorPattern
+	}
+	if len(message) > maxPatternLength {
+		message = strings.ToValidUTF8(message[:maxPatternLength], "") + "…"
+	}
+	return message
+}
+
+// FailureExample points at one failed sample of a cluster.
+type FailureExample struct {
+	Model    string
+	TestName string
+	Sample   int
+	Message  string
+}
+
+// FailureCluster is one failure mode: the failed samples whose errors
+// normalize to the same pattern.
+type FailureCluster struct {
+	Pattern string
+	Count   int
+	Example FailureExample
+}
+
+// FailureGrouping selects whether failures are grouped by test or by model.
+type FailureGrouping int
+
+const (
+	GroupFailuresByTest FailureGrouping = iota
+	GroupFailuresByModel
+)
+
+func (g FailureGrouping) String() string {
+	if g == GroupFailuresByModel {
+		return "model"
+	}
+	return "test"
+}
+
+// FailureGroup holds the failure modes of one test or one model, most common
+// first.
+type FailureGroup struct {
+	Name     string
+	Samples  int
+	Failures int
+	Clusters []FailureCluster
+}
+
+// ClusterFailures groups the failed samples in entries by test or model, and
+// clusters each group's failures by normalized error. A sample with several
+// errors counts once for each distinct pattern. Groups with the most failures
+// come first.
+func ClusterFailures(entries []results.Entry, grouping FailureGrouping) []FailureGroup {
+	groups := make(map[string]*FailureGroup)
+	clusters := make(map[string]map[string]*FailureCluster)
+	var order []string
+
+	for _, entry := range entries {
+		name := entry.TestName
+		if grouping == GroupFailuresByModel {
+			name = entry.ModelID
+		}
+		group, ok := groups[name]
+		if !ok {
+			group = &FailureGroup{Name: name}
+			groups[name] = group
+			clusters[name] = make(map[string]*FailureCluster)
+			order = append(order, name)
+		}
+		group.Samples += len(entry.Samples)
+
+		for _, sample := range entry.Samples {
+			if sample.Success {
+				continue
+			}
+			group.Failures++
+			messages := sample.Errors
+			if len(messages) == 0 {
+				messages = []string{""}
+			}
+			seen := make(map[string]bool, len(messages))
+			for _, message := range messages {
+				pattern := NormalizeError(message)
+				if seen[pattern] {
+					continue
+				}
+				seen[pattern] = true
+				cluster, ok := clusters[name][pattern]
+				if !ok {
+					cluster = &FailureCluster{
+						Pattern: pattern,
+						Example: FailureExample{
+							Model:    entry.ModelID,
+							TestName: entry.TestName,
+							Sample:   sample.Index,
+							Message:  strings.TrimSpace(ansi.Strip(message)),
+						},
+					}
+					clusters[name][pattern] = cluster
+				}
+				cluster.Count++
+			}
+		}
+	}
+
+	result := make([]FailureGroup, 0, len(order))
+	for _, name := range order {
+		group := groups[name]
+		if group.Failures == 0 {
+			continue
+		}
+		for _, cluster := range clusters[name] {
+			group.Clusters = append(group.Clusters, *cluster)
+		}
+		sort.Slice(group.Clusters, func(i, j int) bool {
+			if group.Clusters[i].Count != group.Clusters[j].Count {
+				return group.Clusters[i].Count > group.Clusters[j].Count
+			}
+			return group.Clusters[i].Pattern < group.Clusters[j].Pattern
+		})
+		result = append(result, *group)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Failures > result[j].Failures
+	})
+	return result
+}
@@ -0,0 +1,57 @@
+package analysis
+
+import (
+	"testing"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func TestNormalizeErrorStripsVaryingDetails(t *testing.T) {
+	cases := map[string]string{
+		"/Users/k/svelte-bench/tmp/samples/openrouter/Component.svelte:7:11 Unexpected token\nhttps://svelte.dev/e/js_parse_error": "<file> Unexpected token",
+		"tmp/samples/openai/Component.svelte:12:2 Unexpected token":                                                                "<file> Unexpected token",
+		"expected 3 to be 4 // Object.is equality":                                                                                 "expected <n> to be <n> // Object.is equality",
+		"Cannot read properties of undefined (reading 'trace')\n\n\tin $effect":                                                    "Cannot read properties of undefined (reading 'trace')",
+		"expected 'Hello world\\nCustom inspect: [object …' to contain 'init'":                                                     "expected <value> to contain 'init'",
+		"\x1b[2mexpect(\x1b[22m\x1b[31melement\x1b[39m\x1b[2m).toHaveTextContent()\x1b[22m\n\nExpected: 6":                         "expect(element).toHaveTextContent()",
+		"  \n ": NoErrorPattern,
+	}
+	for message, want := range cases {
+		if got := NormalizeError(message); got != want {
+			t.Errorf("NormalizeError(%q) = %q, want %q", message, got, want)
+		}
+	}
+}
+
+func TestClusterFailuresGroupsByTestAndModel(t *testing.T) {
+	sample := func(index int, errors ...string) results.Sample {
+		return results.Sample{Index: index, Errors: errors}
+	}
+	entries := []results.Entry{
+		{TestName: "derived-by", ModelID: "a", Samples: []results.Sample{
+			sample(0, "Component.svelte:3:1 `$derived.by` is not a function", "Component.svelte:8:1 `$derived.by` is not a function"),
+			sample(1, "Component.svelte:5:9 `$derived.by` is not a function"),
+			sample(2),
+			{Index: 3, Success: true},
+		}},
+		{TestName: "derived-by", ModelID: "b", Samples: []results.Sample{sample(0, "expected 1 to be 2")}},
+		{TestName: "counter", ModelID: "b", Samples: []results.Sample{{Index: 0, Success: true}}},
+	}
+
+	byTest := ClusterFailures(entries, GroupFailuresByTest)
+	if len(byTest) != 1 || byTest[0].Name != "derived-by" || byTest[0].Failures != 4 || byTest[0].Samples != 5 {
+		t.Fatalf("expected one test with failures, got %#v", byTest)
+	}
+	top := byTest[0].Clusters[0]
+	if top.Pattern != "<file> `$derived.by` is not a function" || top.Count != 2 || top.Example.Model != "a" || top.Example.Sample != 0 {
+		t.Fatalf("expected repeated errors in one sample to count once, got %#v", top)
+	}
+	if len(byTest[0].Clusters) != 3 {
+		t.Fatalf("expected three failure modes, got %#v", byTest[0].Clusters)
+	}
+
+	byModel := ClusterFailures(entries, GroupFailuresByModel)
+	if len(byModel) != 2 || byModel[0].Name != "a" || byModel[1].Failures != 1 {
+		t.Fatalf("unexpected grouping by model %#v", byModel)
+	}
+}
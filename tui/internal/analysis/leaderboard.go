@@ -0,0 +1,196 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"time"
+)
+
+// LeaderboardMode selects how repeated runs of a model are combined.
+type LeaderboardMode int
+
+const (
+	// LeaderboardLatest keeps the latest complete run of a model: of its runs
+	// covering the most tests, the newest. A later run of a few tests, such
+	// as a DEBUG_TEST run, does not replace part of a full one.
+	LeaderboardLatest LeaderboardMode = iota
+	// LeaderboardMean averages pass@1 over every stored run of a test.
+	LeaderboardMean
+)
+
+func (m LeaderboardMode) String() string {
+	if m == LeaderboardMean {
+		return "mean of all runs"
+	}
+	return "latest"
+}
+
+// LeaderboardCell is the score of one model on one test category.
+type LeaderboardCell struct {
+	PassAtOne float64
+	PassAtTen float64
+	Samples   int
+	Runs      int
+}
+
+// LeaderboardRow is one provider/model on the leaderboard.
+type LeaderboardRow struct {
+	Provider string
+	ModelID  string
+	Cells    map[string]LeaderboardCell
+	Overall  float64
+	Samples  int
+	LastRun  time.Time
+}
+
+// Leaderboard ranks every stored provider/model by its overall score.
+type Leaderboard struct {
+	Mode  LeaderboardMode
+	Tests []string
+	Rows  []LeaderboardRow
+}
+
+// BuildLeaderboard groups entries by provider and model. The overall score is
+// the mean pass@1 over the tests the model has results for, matching the
+// score shown while a benchmark runs. Runs are told apart by time, as for
+// trends; callers keep runs that are not comparable, such as those with a
+// context file, out of entries.
+func BuildLeaderboard(entries []results.Entry, mode LeaderboardMode) Leaderboard {
+	rows := make(map[string]*LeaderboardRow)
+	order := make([]string, 0)
+	byModel := make(map[string][]results.Entry)
+	testSet := make(map[string]bool)
+
+	for _, entry := range entries {
+		key := entry.Provider + "\x00" + entry.ModelID
+		row, ok := rows[key]
+		if !ok {
+			row = &LeaderboardRow{Provider: entry.Provider, ModelID: entry.ModelID, Cells: make(map[string]LeaderboardCell)}
+			rows[key] = row
+			order = append(order, key)
+		}
+		if entry.Timestamp.After(row.LastRun) {
+			row.LastRun = entry.Timestamp
+		}
+		testSet[entry.TestName] = true
+
+		switch mode {
+		case LeaderboardMean:
+			cell := row.Cells[entry.TestName]
+			// Accumulate the sums of pass@k and divide once every run is seen.
+			cell.PassAtOne += entry.Pass1
+			cell.PassAtTen += entry.Pass10
+			cell.Samples += entry.NumSamples
+			cell.Runs++
+			row.Cells[entry.TestName] = cell
+		default:
+			byModel[key] = append(byModel[key], entry)
+		}
+	}
+
+	for key, modelEntries := range byModel {
+		run := latestCompleteRun(splitRuns(modelEntries))
+		rows[key].LastRun = run[len(run)-1].Timestamp
+		for _, entry := range run {
+			rows[key].Cells[entry.TestName] = LeaderboardCell{PassAtOne: entry.Pass1, PassAtTen: entry.Pass10, Samples: entry.NumSamples, Runs: 1}
+		}
+	}
+
+	board := Leaderboard{Mode: mode, Tests: make([]string, 0, len(testSet))}
+	for test := range testSet {
+		board.Tests = append(board.Tests, test)
+	}
+	sort.Strings(board.Tests)
+
+	for _, key := range order {
+		row := rows[key]
+		total := 0.0
+		for test, cell := range row.Cells {
+			if mode == LeaderboardMean {
+				cell.PassAtOne /= float64(cell.Runs)
+				cell.PassAtTen /= float64(cell.Runs)
+				row.Cells[test] = cell
+			}
+			total += cell.PassAtOne
+			row.Samples += cell.Samples
+		}
+		if len(row.Cells) > 0 {
+			row.Overall = total / float64(len(row.Cells))
+		}
+		board.Rows = append(board.Rows, *row)
+	}
+	board.Sort(SortByOverall, "")
+	return board
+}
+
+// latestCompleteRun returns the newest of the runs, oldest first, that cover
+// the most tests.
+func latestCompleteRun(runs [][]results.Entry) []results.Entry {
+	var best []results.Entry
+	bestTests := 0
+	for _, run := range runs {
+		tests := make(map[string]bool, len(run))
+		for _, entry := range run {
+			tests[entry.TestName] = true
+		}
+		if len(tests) >= bestTests {
+			best, bestTests = run, len(tests)
+		}
+	}
+	return best
+}
+
+// LeaderboardSort selects the column the leaderboard is ordered by.
+type LeaderboardSort int
+
+const (
+	SortByOverall LeaderboardSort = iota
+	SortByModel
+	SortBySamples
+	SortByTest
+)
+
+// Sort orders the rows by the given column; test names the column used with
+// SortByTest. Scores sort best first and models without a result last.
+func (b *Leaderboard) Sort(by LeaderboardSort, test string) {
+	sort.SliceStable(b.Rows, func(i, j int) bool {
+		left, right := b.Rows[i], b.Rows[j]
+		switch by {
+		case SortByModel:
+			return strings.ToLower(left.ModelID) < strings.ToLower(right.ModelID)
+		case SortBySamples:
+			if left.Samples != right.Samples {
+				return left.Samples > right.Samples
+			}
+		case SortByTest:
+			leftCell, leftOK := left.Cells[test]
+			rightCell, rightOK := right.Cells[test]
+			if leftOK != rightOK {
+				return leftOK
+			}
+			if leftCell.PassAtOne != rightCell.PassAtOne {
+				return leftCell.PassAtOne > rightCell.PassAtOne
+			}
+		}
+		if left.Overall != right.Overall {
+			return left.Overall > right.Overall
+		}
+		return strings.ToLower(left.ModelID) < strings.ToLower(right.ModelID)
+	})
+}
+
+// Filter keeps the rows whose provider or model ID contains query.
+func (b Leaderboard) Filter(query string) []LeaderboardRow {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return b.Rows
+	}
+	filtered := make([]LeaderboardRow, 0, len(b.Rows))
+	for _, row := range b.Rows {
+		if strings.Contains(strings.ToLower(row.Provider+" "+row.ModelID), query) {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,103 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func scored(model, test string, pass1 float64, samples int, at time.Time) results.Entry {
+	// pass@10 only has to differ from pass@1 for these tests.
+	pass10 := 1 - (1-pass1)/2
+	return results.Entry{Provider: "OpenAI", ModelID: model, TestName: test, Pass1: pass1, Pass10: pass10, NumSamples: samples, Timestamp: at}
+}
+
+func TestLeaderboardLatestAndMean(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+	entries := []results.Entry{
+		scored("gpt-4o", "counter", 0.2, 10, older),
+		scored("gpt-4o", "counter", 0.6, 10, newer),
+		scored("gpt-4o", "effect", 1.0, 10, older),
+		scored("gpt-5", "counter", 0.9, 5, newer),
+	}
+
+	latest := BuildLeaderboard(entries, LeaderboardLatest)
+	if len(latest.Rows) != 2 || len(latest.Tests) != 2 {
+		t.Fatalf("expected two models over two tests, got %#v", latest)
+	}
+	if latest.Rows[0].ModelID != "gpt-5" {
+		t.Fatalf("expected best overall score first, got %s", latest.Rows[0].ModelID)
+	}
+	// The newer gpt-4o run only covers counter, so the older full run stands.
+	gpt4o := latest.Rows[1]
+	if cell := gpt4o.Cells["counter"]; cell.PassAtOne != 0.2 || cell.PassAtTen != 0.6 || cell.Samples != 10 {
+		t.Fatalf("latest mode should keep the counter result of the complete run, got %#v", cell)
+	}
+	if math.Abs(gpt4o.Overall-0.6) > 1e-9 || gpt4o.Samples != 20 || !gpt4o.LastRun.Equal(older) {
+		t.Fatalf("unexpected overall %v, samples %d or last run %v", gpt4o.Overall, gpt4o.Samples, gpt4o.LastRun)
+	}
+
+	mean := BuildLeaderboard(entries, LeaderboardMean)
+	for _, row := range mean.Rows {
+		if row.ModelID != "gpt-4o" {
+			continue
+		}
+		if cell := row.Cells["counter"]; math.Abs(cell.PassAtOne-0.4) > 1e-9 || math.Abs(cell.PassAtTen-0.7) > 1e-9 || cell.Samples != 20 || cell.Runs != 2 {
+			t.Fatalf("mean mode should average both counter runs, got %#v", cell)
+		}
+		if math.Abs(row.Overall-0.7) > 1e-9 {
+			t.Fatalf("unexpected mean overall %v", row.Overall)
+		}
+	}
+}
+
+func TestLeaderboardLatestKeepsOneWholeRun(t *testing.T) {
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(24 * time.Hour)
+	debug := second.Add(24 * time.Hour)
+	entries := []results.Entry{
+		scored("gpt-5", "counter", 0.2, 10, first),
+		scored("gpt-5", "effect", 0.4, 10, first.Add(time.Second)),
+		scored("gpt-5", "counter", 0.8, 10, second),
+		scored("gpt-5", "effect", 0.6, 10, second.Add(time.Second)),
+		// A 1-sample DEBUG_TEST run of one test.
+		scored("gpt-5", "effect", 0, 1, debug),
+	}
+
+	row := BuildLeaderboard(entries, LeaderboardLatest).Rows[0]
+	if row.Cells["counter"].PassAtOne != 0.8 || row.Cells["effect"].PassAtOne != 0.6 || row.Samples != 20 {
+		t.Fatalf("expected the second full run only, got %#v", row.Cells)
+	}
+	if math.Abs(row.Overall-0.7) > 1e-9 || !row.LastRun.Equal(second.Add(time.Second)) {
+		t.Fatalf("unexpected overall %v or last run %v", row.Overall, row.LastRun)
+	}
+}
+
+func TestLeaderboardSortAndFilter(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	board := BuildLeaderboard([]results.Entry{
+		scored("claude", "counter", 0.5, 10, at),
+		scored("claude", "effect", 1.0, 10, at),
+		scored("gpt-5", "counter", 0.9, 10, at),
+		scored("gpt-5", "effect", 0.7, 10, at),
+		scored("gemini", "effect", 0.1, 10, at),
+	}, LeaderboardLatest)
+
+	board.Sort(SortByTest, "counter")
+	if board.Rows[0].ModelID != "gpt-5" || board.Rows[2].ModelID != "gemini" {
+		t.Fatalf("expected counter order with missing results last, got %s %s %s",
+			board.Rows[0].ModelID, board.Rows[1].ModelID, board.Rows[2].ModelID)
+	}
+
+	board.Sort(SortByModel, "")
+	if board.Rows[0].ModelID != "claude" {
+		t.Fatalf("expected alphabetical order, got %s first", board.Rows[0].ModelID)
+	}
+
+	if rows := board.Filter("GPT"); len(rows) != 1 || rows[0].ModelID != "gpt-5" {
+		t.Fatalf("expected filter to keep gpt-5 only, got %#v", rows)
+	}
+}
@@ -0,0 +1,82 @@
+package analysis
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
+)
+
+// DefaultKValues are the k values the runner itself reports.
+var DefaultKValues = []int{1, 10}
+
+// ParseKValues parses a comma- or space-separated list of k values such as
+// "1,5,10". The result is sorted and free of duplicates.
+func ParseKValues(value string) ([]int, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no k values given")
+	}
+	ks := make([]int, 0, len(fields))
+	for _, field := range fields {
+		k, err := strconv.Atoi(field)
+		if err != nil || k < 1 {
+			return nil, fmt.Errorf("invalid k %q: must be a positive integer", field)
+		}
+		ks = append(ks, k)
+	}
+	slices.Sort(ks)
+	return slices.Compact(ks), nil
+}
+
+// FormatKValues is the inverse of ParseKValues.
+func FormatKValues(ks []int) string {
+	parts := make([]string, len(ks))
+	for i, k := range ks {
+		parts[i] = strconv.Itoa(k)
+	}
+	return strings.Join(parts, ",")
+}
+
+// EntryPassAtK estimates pass@k of one model on one test from its stored
+// sample outcomes. ok is false when fewer than k samples were run, since the
+// estimator is only defined for k <= n. Entries without per-sample data fall
+// back to their sample counts.
+func EntryPassAtK(entry results.Entry, k int) (float64, bool) {
+	n, c := entry.NumSamples, entry.NumCorrect
+	if len(entry.Samples) > 0 {
+		n, c = len(entry.Samples), 0
+		for _, sample := range entry.Samples {
+			if sample.Success {
+				c++
+			}
+		}
+	}
+	if n == 0 || k > n {
+		return 0, false
+	}
+	return stats.PassAtK(n, c, k), true
+}
+
+// TestPassAtK averages pass@k of test over the models in entries that ran at
+// least k samples of it, the same way multi-model runs average pass@1.
+func TestPassAtK(entries []results.Entry, test string, k int) (float64, bool) {
+	total, count := 0.0, 0
+	for _, entry := range entries {
+		if entry.TestName != test {
+			continue
+		}
+		if value, ok := EntryPassAtK(entry, k); ok {
+			total += value
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return total / float64(count), true
+}
@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	"math"
+	"slices"
+	"svelte-bench/tui/internal/results"
+	"testing"
+)
+
+func TestParseKValues(t *testing.T) {
+	ks, err := ParseKValues("10, 1,5 5")
+	if err != nil || !slices.Equal(ks, []int{1, 5, 10}) {
+		t.Fatalf("ParseKValues() = %v, %v", ks, err)
+	}
+	if FormatKValues(ks) != "1,5,10" {
+		t.Fatalf("FormatKValues() = %q", FormatKValues(ks))
+	}
+	for _, value := range []string{"", "0", "1,x", "-2"} {
+		if _, err := ParseKValues(value); err == nil {
+			t.Errorf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestEntryPassAtKUsesSampleOutcomes(t *testing.T) {
+	e := entry("gpt-4o", "counter", append(repeat(true, 3), repeat(false, 7)...)...)
+	// Stored counts disagree with the samples; the samples win.
+	e.NumCorrect = 9
+
+	if value, ok := EntryPassAtK(e, 1); !ok || math.Abs(value-0.3) > 1e-12 {
+		t.Fatalf("pass@1 = %v, %v", value, ok)
+	}
+	if value, ok := EntryPassAtK(e, 2); !ok || math.Abs(value-(1-21.0/45)) > 1e-12 {
+		t.Fatalf("pass@2 = %v, %v", value, ok)
+	}
+	if value, ok := EntryPassAtK(e, 10); !ok || value != 1 {
+		t.Fatalf("pass@10 = %v, %v", value, ok)
+	}
+	if _, ok := EntryPassAtK(e, 11); ok {
+		t.Fatal("pass@11 is undefined with ten samples")
+	}
+	if value, ok := EntryPassAtK(results.Entry{NumSamples: 5, NumCorrect: 2}, 1); !ok || math.Abs(value-0.4) > 1e-12 {
+		t.Fatalf("expected counts to be used without samples, got %v, %v", value, ok)
+	}
+}
+
+func TestTestPassAtKAveragesModelsWithEnoughSamples(t *testing.T) {
+	entries := []results.Entry{
+		entry("a", "counter", append(repeat(true, 5), repeat(false, 5)...)...),
+		entry("b", "counter", repeat(true, 10)...),
+		entry("c", "counter", true, false),
+		entry("a", "effect", repeat(false, 10)...),
+	}
+	if value, ok := TestPassAtK(entries, "counter", 1); !ok || math.Abs(value-(0.5+1+0.5)/3) > 1e-12 {
+		t.Fatalf("pass@1 = %v, %v", value, ok)
+	}
+	want := (1 - 1.0/252 + 1) / 2
+	if value, ok := TestPassAtK(entries, "counter", 5); !ok || math.Abs(value-want) > 1e-12 {
+		t.Fatalf("pass@5 = %v, want %v", value, want)
+	}
+	if _, ok := TestPassAtK(entries, "snippets", 1); ok {
+		t.Fatal("expected no value for a missing test")
+	}
+}
@@ -0,0 +1,82 @@
+package analysis
+
+import (
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
+)
+
+// DefaultRegressionMargin is the pass@1 drop, in absolute terms, that counts
+// as a regression even when it is not statistically significant.
+const DefaultRegressionMargin = 0.1
+
+// marginTolerance keeps a drop of exactly the margin, such as 80% to 70%, from
+// counting as a regression through floating-point error.
+const marginTolerance = 1e-9
+
+// Regression compares one model on one test category against its baseline.
+type Regression struct {
+	Model         string
+	TestName      string
+	Baseline      Outcome
+	Candidate     Outcome
+	Delta         float64
+	PValue        float64
+	ExceedsMargin bool
+	Significant   bool
+}
+
+// Regressed reports whether the test should block a change: pass@1 dropped by
+// more than the margin, or by an amount unlikely to be sampling noise.
+func (r Regression) Regressed() bool {
+	return r.ExceedsMargin || (r.Significant && r.Delta < 0)
+}
+
+// CheckRegressions compares each model in candidate with the same model in
+// baseline, test by test. Tests or models missing from either side are not
+// compared.
+func CheckRegressions(baseline, candidate []results.Entry, margin float64) []Regression {
+	baseByModel := entriesByModel(baseline)
+	var checks []Regression
+	for _, model := range ModelIDs(candidate) {
+		base, ok := baseByModel[model]
+		if !ok {
+			continue
+		}
+		comparison := Compare(base, entriesByModel(candidate)[model])
+		for _, row := range comparison.Rows {
+			if !row.Base.Present || !row.Candidate.Present {
+				continue
+			}
+			checks = append(checks, Regression{
+				Model:         model,
+				TestName:      row.TestName,
+				Baseline:      row.Base,
+				Candidate:     row.Candidate,
+				Delta:         row.Delta,
+				PValue:        row.PValue,
+				ExceedsMargin: row.Delta < -margin-marginTolerance,
+				Significant:   row.PValue < stats.SignificanceLevel,
+			})
+		}
+	}
+	return checks
+}
+
+// Regressions keeps the checks that regressed.
+func Regressions(checks []Regression) []Regression {
+	var regressed []Regression
+	for _, check := range checks {
+		if check.Regressed() {
+			regressed = append(regressed, check)
+		}
+	}
+	return regressed
+}
+
+func entriesByModel(entries []results.Entry) map[string][]results.Entry {
+	byModel := make(map[string][]results.Entry)
+	for _, entry := range entries {
+		byModel[entry.ModelID] = append(byModel[entry.ModelID], entry)
+	}
+	return byModel
+}
@@ -0,0 +1,45 @@
+package analysis
+
+import (
+	"testing"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func TestCheckRegressionsFlagsMarginAndSignificantDrops(t *testing.T) {
+	baseline := []results.Entry{
+		entry("gpt-5", "counter", append(repeat(true, 8), repeat(false, 2)...)...),
+		entry("gpt-5", "effect", repeat(true, 10)...),
+		entry("gpt-5", "props", append(repeat(true, 8), repeat(false, 2)...)...),
+		entry("claude", "counter", repeat(true, 10)...),
+	}
+	candidate := []results.Entry{
+		// An exact ten point drop stays within the default margin.
+		entry("gpt-5", "counter", append(repeat(true, 7), repeat(false, 3)...)...),
+		entry("gpt-5", "effect", append(repeat(true, 2), repeat(false, 8)...)...),
+		entry("gpt-5", "props", append(repeat(true, 6), repeat(false, 4)...)...),
+		entry("gpt-5", "snippets", repeat(false, 10)...),
+	}
+
+	checks := CheckRegressions(baseline, candidate, DefaultRegressionMargin)
+	if len(checks) != 3 {
+		t.Fatalf("expected the three tests present on both sides, got %#v", checks)
+	}
+
+	byTest := make(map[string]Regression)
+	for _, check := range checks {
+		byTest[check.TestName] = check
+	}
+	if byTest["counter"].Regressed() {
+		t.Fatal("a drop equal to the margin should not regress")
+	}
+	if effect := byTest["effect"]; !effect.Regressed() || !effect.Significant || !effect.ExceedsMargin {
+		t.Fatalf("expected a significant drop beyond the margin, got %#v", effect)
+	}
+	if props := byTest["props"]; !props.Regressed() || props.Significant {
+		t.Fatalf("expected props to regress on the margin alone, got %#v", props)
+	}
+	if regressed := Regressions(checks); len(regressed) != 2 {
+		t.Fatalf("expected two regressions, got %#v", regressed)
+	}
+}
@@ -0,0 +1,47 @@
+package analysis
+
+import (
+	"sort"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
+)
+
+// ModelScore is the overall score of one model within a run.
+type ModelScore struct {
+	Provider string
+	Model    string
+	Tests    []string
+	Outcomes []stats.Proportion
+	Overall  float64
+	Interval stats.Interval
+	// TiedWithPrevious is set when the difference from the next better model
+	// is within sampling noise.
+	TiedWithPrevious bool
+}
+
+// ScoreModels computes each model's overall pass@1 with a bootstrap interval,
+// best first, and marks neighbours whose difference is not significant.
+func ScoreModels(entries []results.Entry) []ModelScore {
+	byModel := entriesByModel(entries)
+	scores := make([]ModelScore, 0, len(byModel))
+	for _, model := range ModelIDs(entries) {
+		score := ModelScore{Model: model}
+		for _, entry := range byModel[model] {
+			score.Provider = entry.Provider
+			score.Tests = append(score.Tests, entry.TestName)
+			score.Outcomes = append(score.Outcomes, stats.Proportion{Correct: entry.NumCorrect, Total: entry.NumSamples})
+		}
+		score.Overall = stats.MeanRate(score.Outcomes)
+		score.Interval = stats.BootstrapMean(score.Outcomes)
+		scores = append(scores, score)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Overall > scores[j].Overall
+	})
+	for i := 1; i < len(scores); i++ {
+		difference := stats.BootstrapDifference(scores[i-1].Outcomes, scores[i].Outcomes)
+		scores[i].TiedWithPrevious = difference.Contains(0)
+	}
+	return scores
+}
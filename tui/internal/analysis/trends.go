@@ -0,0 +1,187 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"time"
+)
+
+// runWindow groups the entries of one model saved within this long of each
+// other into one run. The runner stamps every entry of a results file as it
+// saves it, so a file's entries are milliseconds apart.
+const runWindow = time.Minute
+
+// splitRuns sorts the entries of one model by time and splits them into runs
+// with runWindow, oldest first.
+func splitRuns(entries []results.Entry) [][]results.Entry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	var runs [][]results.Entry
+	start := 0
+	for i := 1; i <= len(entries); i++ {
+		if i == len(entries) || entries[i].Timestamp.Sub(entries[start].Timestamp) > runWindow {
+			runs = append(runs, entries[start:i])
+			start = i
+		}
+	}
+	return runs
+}
+
+// TrendMatch selects how a trend query matches models.
+type TrendMatch int
+
+const (
+	// TrendPrefix matches every model whose "provider/model" key or model ID
+	// starts with the query, such as a family like "openai/gpt-5".
+	TrendPrefix TrendMatch = iota
+	// TrendExact matches one model by its key or model ID.
+	TrendExact
+)
+
+func (m TrendMatch) String() string {
+	if m == TrendExact {
+		return "exact model"
+	}
+	return "model family prefix"
+}
+
+// ModelKey identifies a model across providers as "provider/model", in lower
+// case.
+func ModelKey(provider, model string) string {
+	return strings.ToLower(provider + "/" + model)
+}
+
+// MatchesModel reports whether entry belongs to the model or family query
+// names. Matching is case-insensitive.
+func MatchesModel(entry results.Entry, query string, match TrendMatch) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return false
+	}
+	key := ModelKey(entry.Provider, entry.ModelID)
+	model := strings.ToLower(entry.ModelID)
+	if match == TrendExact {
+		return key == query || model == query
+	}
+	return strings.HasPrefix(key, query) || strings.HasPrefix(model, query)
+}
+
+// TrendPoint is one run of one model.
+type TrendPoint struct {
+	Date     time.Time
+	Provider string
+	Model    string
+	Overall  float64
+	Tests    map[string]float64
+	Samples  int
+}
+
+// Trend is the history of the runs of every model matching a query, oldest
+// first.
+type Trend struct {
+	Query  string
+	Match  TrendMatch
+	Models []string
+	Tests  []string
+	Points []TrendPoint
+}
+
+// BuildTrend collects the runs of the models matching query. A run's overall
+// score is its mean pass@1 over the tests it ran, as on the leaderboard.
+func BuildTrend(entries []results.Entry, query string, match TrendMatch) Trend {
+	trend := Trend{Query: query, Match: match}
+	byModel := make(map[string][]results.Entry)
+	var models []string
+	for _, entry := range entries {
+		if !MatchesModel(entry, query, match) {
+			continue
+		}
+		key := ModelKey(entry.Provider, entry.ModelID)
+		if _, ok := byModel[key]; !ok {
+			models = append(models, key)
+		}
+		byModel[key] = append(byModel[key], entry)
+	}
+
+	testSet := make(map[string]bool)
+	for _, key := range models {
+		modelEntries := byModel[key]
+		for _, run := range splitRuns(modelEntries) {
+			point := TrendPoint{
+				Date:     run[0].Timestamp,
+				Provider: run[0].Provider,
+				Model:    run[0].ModelID,
+				Tests:    make(map[string]float64),
+			}
+			for _, entry := range run {
+				point.Tests[entry.TestName] = entry.Pass1
+				point.Samples += entry.NumSamples
+				testSet[entry.TestName] = true
+			}
+			trend.Points = append(trend.Points, finishTrendPoint(point))
+		}
+		trend.Models = append(trend.Models, modelEntries[0].ModelID)
+	}
+
+	sort.SliceStable(trend.Points, func(i, j int) bool {
+		return trend.Points[i].Date.Before(trend.Points[j].Date)
+	})
+	for test := range testSet {
+		trend.Tests = append(trend.Tests, test)
+	}
+	sort.Strings(trend.Tests)
+	return trend
+}
+
+func finishTrendPoint(point TrendPoint) TrendPoint {
+	total := 0.0
+	for _, pass1 := range point.Tests {
+		total += pass1
+	}
+	if len(point.Tests) > 0 {
+		point.Overall = total / float64(len(point.Tests))
+	}
+	return point
+}
+
+// OverallSeries returns the overall score of each run, oldest first.
+func (t Trend) OverallSeries() []float64 {
+	series := make([]float64, len(t.Points))
+	for i, point := range t.Points {
+		series[i] = point.Overall
+	}
+	return series
+}
+
+// TestSeries returns pass@1 of test for each run, oldest first, with NaN for
+// runs that did not include it.
+func (t Trend) TestSeries(test string) []float64 {
+	series := make([]float64, len(t.Points))
+	for i, point := range t.Points {
+		pass1, ok := point.Tests[test]
+		if !ok {
+			pass1 = math.NaN()
+		}
+		series[i] = pass1
+	}
+	return series
+}
+
+// ModelKeys lists the distinct "provider/model" keys in entries, sorted, for
+// offering models to pick from.
+func ModelKeys(entries []results.Entry) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, entry := range entries {
+		key := ModelKey(entry.Provider, entry.ModelID)
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
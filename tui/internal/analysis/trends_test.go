@@ -0,0 +1,47 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func TestBuildTrendGroupsRunsByModelAndDate(t *testing.T) {
+	day := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	entry := func(model, test string, pass1 float64, at time.Time) results.Entry {
+		return results.Entry{Provider: "OpenAI", ModelID: model, TestName: test, Pass1: pass1, NumSamples: 10, Timestamp: at}
+	}
+	entries := []results.Entry{
+		entry("gpt-5", "counter", 0.4, day),
+		entry("gpt-5", "effect", 0.6, day.Add(2*time.Millisecond)),
+		entry("gpt-5", "counter", 0.8, day.Add(48*time.Hour)),
+		entry("gpt-5-mini", "counter", 0.2, day.Add(24*time.Hour)),
+		entry("gpt-4o", "counter", 1.0, day),
+	}
+
+	family := BuildTrend(entries, "openai/gpt-5", TrendPrefix)
+	if len(family.Points) != 3 || len(family.Models) != 2 {
+		t.Fatalf("expected three runs of two gpt-5 models, got %#v", family)
+	}
+	if first := family.Points[0]; first.Model != "gpt-5" || math.Abs(first.Overall-0.5) > 1e-9 || first.Samples != 20 {
+		t.Fatalf("expected entries saved together to form one run, got %#v", first)
+	}
+	if family.Points[1].Model != "gpt-5-mini" {
+		t.Fatalf("expected runs ordered by date, got %#v", family.Points)
+	}
+
+	effect := family.TestSeries("effect")
+	if effect[0] != 0.6 || !math.IsNaN(effect[1]) || !math.IsNaN(effect[2]) {
+		t.Fatalf("expected gaps for runs without the test, got %v", effect)
+	}
+
+	exact := BuildTrend(entries, "OpenAI/GPT-5", TrendExact)
+	if len(exact.Points) != 2 || exact.OverallSeries()[1] != 0.8 {
+		t.Fatalf("expected only gpt-5 runs in exact mode, got %#v", exact.Points)
+	}
+	if byID := BuildTrend(entries, "gpt-4o", TrendExact); len(byID.Points) != 1 {
+		t.Fatalf("expected a bare model ID to match, got %#v", byID.Points)
+	}
+}
@@ -0,0 +1,84 @@
+package bridge
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// BaselinesFileName is the file that maps model IDs to the results file used
+// as their baseline. It lives in BaselinesDir under the benchmarks directory,
+// so it can be committed with the results and used by CI, while the
+// TypeScript build, which reads every .json file at the top level of
+// benchmarks/ as results, does not see it.
+const (
+	BaselinesDir      = "baselines"
+	BaselinesFileName = "baselines.json"
+)
+
+// BaselinesPath returns the baselines file of the benchmarks directory dir.
+func BaselinesPath(dir string) string {
+	return filepath.Join(dir, BaselinesDir, BaselinesFileName)
+}
+
+// Baselines maps a model ID to the path of its baseline results file.
+type Baselines map[string]string
+
+// LoadBaselines reads the baselines of dir. A missing file means no model has
+// a baseline yet. Paths are returned absolute.
+func LoadBaselines(dir string) (Baselines, error) {
+	data, err := os.ReadFile(BaselinesPath(dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return Baselines{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stored map[string]string
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+	baselines := make(Baselines, len(stored))
+	for model, path := range stored {
+		path = filepath.FromSlash(path)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		baselines[model] = path
+	}
+	return baselines, nil
+}
+
+// SetBaseline makes run the baseline of every model it contains.
+func SetBaseline(dir string, run BenchmarkRun) error {
+	baselines, err := LoadBaselines(dir)
+	if err != nil {
+		return err
+	}
+	for _, model := range run.Models {
+		baselines[model] = run.Path
+	}
+	return saveBaselines(dir, baselines)
+}
+
+// saveBaselines writes baselines with paths relative to dir where possible,
+// so the file stays valid in another checkout.
+func saveBaselines(dir string, baselines Baselines) error {
+	stored := make(map[string]string, len(baselines))
+	for model, path := range baselines {
+		if relative, err := filepath.Rel(dir, path); err == nil && filepath.IsLocal(relative) {
+			path = filepath.ToSlash(relative)
+		}
+		stored[model] = path
+	}
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(BaselinesPath(dir)), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(BaselinesPath(dir), append(data, '\n'), 0644)
+}
@@ -0,0 +1,43 @@
+package bridge
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetBaselineStoresRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	if baselines, err := LoadBaselines(dir); err != nil || len(baselines) != 0 {
+		t.Fatalf("expected no baselines without a file, got %v, %v", baselines, err)
+	}
+
+	first := BenchmarkRun{Path: filepath.Join(dir, "benchmark-results-a.json"), Models: []string{"gpt-5", "claude"}}
+	second := BenchmarkRun{Path: filepath.Join(dir, "benchmark-results-b.json"), Models: []string{"gpt-5"}}
+	if err := SetBaseline(dir, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetBaseline(dir, second); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(BaselinesPath(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := filepath.Glob(filepath.Join(dir, "*.json")); len(entries) != 0 {
+		t.Fatalf("the TypeScript build would load %v as results", entries)
+	}
+	if strings.Contains(string(data), dir) {
+		t.Fatalf("baselines should be stored relative to the benchmarks directory:\n%s", data)
+	}
+
+	baselines, err := LoadBaselines(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baselines["gpt-5"] != second.Path || baselines["claude"] != first.Path {
+		t.Fatalf("unexpected baselines %#v", baselines)
+	}
+}
@@ -0,0 +1,183 @@
+package bridge
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"time"
+
+	"github.com/sahilm/fuzzy"
+)
+
+// BenchmarkRun summarizes one benchmark-results file in the benchmarks
+// directory. Results hold the per-test entries without their samples; load
+// the file with results.Load when the generated code is needed.
+type BenchmarkRun struct {
+	Path             string
+	Layout           results.Layout
+	Date             time.Time
+	Provider         string
+	Models           []string
+	Samples          int
+	AveragePassAtOne float64
+	Results          []results.Entry
+}
+
+// Name returns the file name of the run.
+func (r BenchmarkRun) Name() string {
+	return filepath.Base(r.Path)
+}
+
+// GetBenchmarksDir returns the directory the TypeScript runner writes results to.
+func GetBenchmarksDir() (string, error) {
+	projectRoot, err := getProjectRoot()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(projectRoot, "benchmarks"), nil
+}
+
+// ListBenchmarkRuns scans dir for results files, newest first, and its
+// legacy v1 directory too when includeV1 is set. The v1 runs were scored
+// against the first test suite under the same test names, so callers must
+// opt in to them and keep them apart. Files that cannot be parsed are skipped
+// so one partially written run does not hide the rest of the history.
+func ListBenchmarkRuns(dir string, includeV1 bool) ([]BenchmarkRun, error) {
+	files, err := results.ListFiles(dir, includeV1)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]BenchmarkRun, 0, len(files))
+	for _, path := range files {
+		run, err := LoadBenchmarkRun(path)
+		if err != nil || len(run.Results) == 0 {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	SortBenchmarkRuns(runs, SortRunsByDate)
+	return runs, nil
+}
+
+// RunResultFiles returns the results files in dir that were written at or
+// after since. The runner saves one file per model as each finishes, so these
+// are the files of a run that started at since.
+func RunResultFiles(dir string, since time.Time) ([]string, error) {
+	files, err := results.ListFiles(dir, false)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]string, 0)
+	for _, path := range files {
+		if !results.FileTime(path).Before(since) {
+			recent = append(recent, path)
+		}
+	}
+	return recent, nil
+}
+
+// LoadBenchmarkRun reads a benchmark-results file into a run summary.
+func LoadBenchmarkRun(path string) (BenchmarkRun, error) {
+	run := BenchmarkRun{
+		Path:   path,
+		Layout: results.DetectLayout(path),
+		Date:   results.FileTime(path),
+	}
+	_, err := results.Stream(path, func(entry results.Entry) error {
+		entry.Samples = nil
+		run.Results = append(run.Results, entry)
+		return nil
+	})
+	if err != nil {
+		return BenchmarkRun{}, err
+	}
+	summarizeRun(&run)
+	return run, nil
+}
+
+func summarizeRun(run *BenchmarkRun) {
+	seen := make(map[string]bool)
+	total := 0.0
+	for _, result := range run.Results {
+		if run.Provider == "" {
+			run.Provider = result.Provider
+		}
+		if !seen[result.ModelID] {
+			seen[result.ModelID] = true
+			run.Models = append(run.Models, result.ModelID)
+		}
+		if result.NumSamples > run.Samples {
+			run.Samples = result.NumSamples
+		}
+		total += result.Pass1
+	}
+	if len(run.Results) > 0 {
+		run.AveragePassAtOne = total / float64(len(run.Results))
+	}
+}
+
+// RunSortOrder selects how benchmark runs are ordered.
+type RunSortOrder int
+
+const (
+	SortRunsByDate RunSortOrder = iota
+	SortRunsByScore
+)
+
+// SortBenchmarkRuns orders runs newest first or best average pass@1 first.
+func SortBenchmarkRuns(runs []BenchmarkRun, order RunSortOrder) {
+	sort.SliceStable(runs, func(i, j int) bool {
+		if order == SortRunsByScore && runs[i].AveragePassAtOne != runs[j].AveragePassAtOne {
+			return runs[i].AveragePassAtOne > runs[j].AveragePassAtOne
+		}
+		if !runs[i].Date.Equal(runs[j].Date) {
+			return runs[i].Date.After(runs[j].Date)
+		}
+		return runs[i].Path < runs[j].Path
+	})
+}
+
+// FilterBenchmarkRuns fuzzy-matches query against each run's provider and
+// model IDs, keeping the current order for equally relevant runs.
+func FilterBenchmarkRuns(runs []BenchmarkRun, query string) []BenchmarkRun {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return runs
+	}
+
+	type scoredRun struct {
+		run   BenchmarkRun
+		score int
+		index int
+	}
+	scored := make([]scoredRun, 0, len(runs))
+	for index, run := range runs {
+		searchText := strings.ToLower(run.Provider + " " + strings.Join(run.Models, " "))
+		match := fuzzy.Find(query, []string{searchText})
+		if len(match) == 0 {
+			continue
+		}
+		score := match[0].Score
+		if strings.Contains(searchText, query) {
+			score += 5000
+		}
+		scored = append(scored, scoredRun{run: run, score: score, index: index})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score == scored[j].score {
+			return scored[i].index < scored[j].index
+		}
+		return scored[i].score > scored[j].score
+	})
+
+	filtered := make([]BenchmarkRun, len(scored))
+	for i, candidate := range scored {
+		filtered[i] = candidate.run
+	}
+	return filtered
+}
@@ -0,0 +1,90 @@
+package bridge
+
+import (
+	"os"
+	"path/filepath"
+	"svelte-bench/tui/internal/results"
+	"testing"
+	"time"
+)
+
+func writeRunFixture(t *testing.T, dir, name, body string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListBenchmarkRunsSummarizesStoredFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeRunFixture(t, dir, "benchmark-results-2025-10-17T19-39-14.181Z.json",
+		`[{"testName":"counter","provider":"OpenAI","modelId":"gpt-4o","numSamples":10,"numCorrect":8,"pass1":0.8,"pass10":1,"samples":[]},
+		  {"testName":"effect","provider":"OpenAI","modelId":"gpt-4o","numSamples":10,"numCorrect":4,"pass1":0.4,"pass10":1,"samples":[]}]`)
+	writeRunFixture(t, dir, "benchmark-results-2025-12-01T08-00-00.000Z.json",
+		`[{"testName":"counter","provider":"OpenRouter","modelId":"anthropic/claude-sonnet-4","numSamples":1,"numCorrect":1,"pass1":1,"pass10":1,"samples":[]}]`)
+	writeRunFixture(t, dir, "benchmark-results-merged.json", `[]`)
+	writeRunFixture(t, dir, "benchmark-results-2025-12-02T08-00-00.000Z.json", `[{"testName":`)
+
+	runs, err := ListBenchmarkRuns(dir, false)
+	if err != nil {
+		t.Fatalf("ListBenchmarkRuns returned error: %v", err)
+	}
+	if len(runs) != 2 {
+		t.Fatalf("expected merged and malformed files to be skipped, got %d runs", len(runs))
+	}
+	if runs[0].Provider != "OpenRouter" {
+		t.Fatalf("expected newest run first, got %s", runs[0].Name())
+	}
+
+	older := runs[1]
+	if want := time.Date(2025, time.October, 17, 19, 39, 14, 181000000, time.UTC); !older.Date.Equal(want) {
+		t.Fatalf("expected date from file name %s, got %s", want, older.Date)
+	}
+	if older.Samples != 10 || len(older.Models) != 1 || older.Models[0] != "gpt-4o" {
+		t.Fatalf("unexpected run summary: %#v", older)
+	}
+	if older.AveragePassAtOne < 0.599 || older.AveragePassAtOne > 0.601 {
+		t.Fatalf("expected average pass@1 of 0.6, got %v", older.AveragePassAtOne)
+	}
+
+	SortBenchmarkRuns(runs, SortRunsByScore)
+	if runs[0].Provider != "OpenRouter" || runs[1].Provider != "OpenAI" {
+		t.Fatal("expected score sort to put the best run first")
+	}
+}
+
+func TestListBenchmarkRunsLeavesOutV1UnlessAsked(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "v1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	entry := `[{"testName":"counter","provider":"OpenAI","modelId":"gpt-4o","numSamples":1,"numCorrect":1,"pass1":1,"pass10":1,"samples":[]}]`
+	writeRunFixture(t, dir, "benchmark-results-2025-10-17T19-39-14.181Z.json", entry)
+	writeRunFixture(t, dir, filepath.Join("v1", "v1-benchmark-results-2025-05-03T19-47-53.331Z.json"), entry)
+
+	runs, err := ListBenchmarkRuns(dir, false)
+	if err != nil || len(runs) != 1 || runs[0].Layout != results.LayoutCurrent {
+		t.Fatalf("expected only the current run, got %d runs (%v)", len(runs), err)
+	}
+	runs, err = ListBenchmarkRuns(dir, true)
+	if err != nil || len(runs) != 2 || runs[1].Layout != results.LayoutV1 {
+		t.Fatalf("expected the v1 run when asked, got %d runs (%v)", len(runs), err)
+	}
+}
+
+func TestFilterBenchmarkRunsMatchesProviderAndModel(t *testing.T) {
+	runs := []BenchmarkRun{
+		{Provider: "OpenAI", Models: []string{"gpt-4o"}},
+		{Provider: "OpenRouter", Models: []string{"anthropic/claude-sonnet-4"}},
+	}
+
+	if got := FilterBenchmarkRuns(runs, "sonnet"); len(got) != 1 || got[0].Provider != "OpenRouter" {
+		t.Fatalf("expected model filter to match OpenRouter run, got %#v", got)
+	}
+	if got := FilterBenchmarkRuns(runs, "openai"); len(got) == 0 || got[0].Models[0] != "gpt-4o" {
+		t.Fatalf("expected provider filter to rank the OpenAI run first, got %#v", got)
+	}
+	if got := FilterBenchmarkRuns(runs, ""); len(got) != 2 {
+		t.Fatal("expected an empty filter to keep every run")
+	}
+}
@@ -0,0 +1,246 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"svelte-bench/tui/internal/config"
+	"svelte-bench/tui/internal/results"
+)
+
+// manifestSchemaVersion is bumped when fields change meaning.
+const manifestSchemaVersion = 1
+
+// provenanceTimeout bounds each git, node or pnpm call, so a hung tool cannot
+// delay a benchmark.
+const provenanceTimeout = 5 * time.Second
+
+// Version is the TUI version, set at build time with
+// -ldflags "-X svelte-bench/tui/internal/bridge.Version=v1.2.3". Builds
+// without it report the module version and VCS revision Go recorded.
+var Version = ""
+
+// RunManifest records how a run was made, so stored runs can be checked for
+// comparability.
+type RunManifest struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	TUIVersion    string `json:"tuiVersion"`
+	Git           struct {
+		Commit string `json:"commit,omitempty"`
+		// Dirty is true when tracked files had uncommitted changes when the
+		// run started.
+		Dirty bool `json:"dirty"`
+	} `json:"git"`
+	NodeVersion   string   `json:"nodeVersion,omitempty"`
+	PnpmVersion   string   `json:"pnpmVersion,omitempty"`
+	ExecutionMode string   `json:"executionMode"`
+	Provider      string   `json:"provider"`
+	Models        []string `json:"models"`
+	Samples       int      `json:"samples"`
+	// TestFilter is the DEBUG_TEST selection, a comma-separated list of
+	// tests, empty when every test ran.
+	TestFilter string `json:"testFilter,omitempty"`
+	// Tests are the tests the runner started, in order.
+	Tests []string `json:"tests"`
+	// Suite fingerprints the tests the run was scored against; nil when
+	// src/tests could not be read.
+	Suite *SuiteFingerprint `json:"suite,omitempty"`
+	// ContextFile is the context file given to the runner; empty when none
+	// was used.
+	ContextFile  string               `json:"contextFile,omitempty"`
+	Retry        config.RetrySettings `json:"retry"`
+	StartedAt    time.Time            `json:"startedAt"`
+	FinishedAt   time.Time            `json:"finishedAt"`
+	ExitStatus   int                  `json:"exitStatus"`
+	Error        string               `json:"error,omitempty"`
+	ResultsFiles []string             `json:"resultsFiles"`
+}
+
+// retrySettings applies the runner's defaults to the RETRY_* variables of env.
+func retrySettings(env map[string]string) config.RetrySettings {
+	settings := config.RetrySettings{MaxAttempts: 5, InitialDelayMs: 1000, MaxDelayMs: 30000, BackoffFactor: 2}
+	if value, err := strconv.Atoi(env["RETRY_MAX_ATTEMPTS"]); err == nil {
+		settings.MaxAttempts = value
+	}
+	if value, err := strconv.Atoi(env["RETRY_INITIAL_DELAY_MS"]); err == nil {
+		settings.InitialDelayMs = value
+	}
+	if value, err := strconv.Atoi(env["RETRY_MAX_DELAY_MS"]); err == nil {
+		settings.MaxDelayMs = value
+	}
+	if value, err := strconv.ParseFloat(env["RETRY_BACKOFF_FACTOR"], 64); err == nil {
+		settings.BackoffFactor = value
+	}
+	return settings
+}
+
+// newRunManifest records the configuration and toolchain of a run about to
+// start in projectRoot with env.
+func newRunManifest(projectRoot string, config BenchmarkConfig, env []string, started time.Time) RunManifest {
+	values := make(map[string]string, len(env))
+	for _, entry := range env {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			values[key] = value
+		}
+	}
+
+	manifest := RunManifest{
+		SchemaVersion: manifestSchemaVersion,
+		TUIVersion:    TUIVersion(),
+		NodeVersion:   strings.TrimPrefix(toolOutput(projectRoot, "node", "--version"), "v"),
+		PnpmVersion:   toolOutput(projectRoot, "pnpm", "--version"),
+		ExecutionMode: executionMode(config),
+		Provider:      config.Provider,
+		Models:        []string{},
+		Samples:       config.Samples,
+		TestFilter:    values["DEBUG_TEST"],
+		Tests:         []string{},
+		ContextFile:   values["CONTEXT_FILE"],
+		Retry:         retrySettings(values),
+		StartedAt:     started.UTC(),
+		ResultsFiles:  []string{},
+	}
+	for _, model := range strings.Split(config.Model, ",") {
+		if model = strings.TrimSpace(model); model != "" {
+			manifest.Models = append(manifest.Models, model)
+		}
+	}
+	if suite, err := FingerprintSuite(filepath.Join(projectRoot, "src", "tests")); err == nil {
+		manifest.Suite = &suite
+	}
+	manifest.Git.Commit = toolOutput(projectRoot, "git", "rev-parse", "HEAD")
+	if manifest.Git.Commit != "" {
+		status := toolOutput(projectRoot, "git", "status", "--porcelain", "--untracked-files=no")
+		manifest.Git.Dirty = status != ""
+	}
+	return manifest
+}
+
+func executionMode(config BenchmarkConfig) string {
+	switch {
+	case config.Madmax:
+		return "madmax"
+	case config.Parallel:
+		return "parallel"
+	}
+	return "sequential"
+}
+
+// toolOutput runs a tool in dir and returns its trimmed output, or "" when it
+// is missing or fails.
+func toolOutput(dir, name string, args ...string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), provenanceTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// TUIVersion returns Version, or the version and VCS revision recorded in the
+// binary, such as "(devel)+3f2a9c1-dirty".
+func TUIVersion() string {
+	if Version != "" {
+		return Version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	version := info.Main.Version
+	revision, modified := "", false
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if revision != "" {
+		version += "+" + revision[:min(len(revision), 7)]
+		if modified {
+			version += "-dirty"
+		}
+	}
+	return version
+}
+
+// finish records the end of the run and its outcome.
+func (m *RunManifest) finish(finished time.Time, runErr error) {
+	m.FinishedAt = finished.UTC()
+	if runErr == nil {
+		return
+	}
+	m.Error = runErr.Error()
+	m.ExitStatus = -1
+	var exitErr *exec.ExitError
+	if errors.As(runErr, &exitErr) && exitErr.ExitCode() >= 0 {
+		m.ExitStatus = exitErr.ExitCode()
+	}
+}
+
+// ManifestPath returns the manifest path of a results file, in the manifests
+// directory beside it.
+func ManifestPath(resultsPath string) string {
+	name := strings.TrimSuffix(filepath.Base(resultsPath), ".json") + results.ManifestSuffix
+	return filepath.Join(filepath.Dir(resultsPath), results.ManifestDir, name)
+}
+
+// writeRunManifests writes the manifest of each results file of the run, or
+// one named after the start time when the run saved none, so failed runs are
+// recorded too. Manifests go to the manifests directory of dir, where the
+// TypeScript build does not mistake them for results.
+func writeRunManifests(dir string, manifest RunManifest, resultsFiles []string) error {
+	for _, path := range resultsFiles {
+		manifest.ResultsFiles = append(manifest.ResultsFiles, filepath.Base(path))
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	paths := make([]string, 0, len(resultsFiles))
+	for _, path := range resultsFiles {
+		paths = append(paths, ManifestPath(path))
+	}
+	if len(paths) == 0 {
+		name := "benchmark-run-" + strings.ReplaceAll(manifest.StartedAt.Format("2006-01-02T15:04:05.000Z"), ":", "-") + results.ManifestSuffix
+		paths = append(paths, filepath.Join(dir, results.ManifestDir, name))
+	}
+
+	var errs []error
+	for _, path := range paths {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LoadManifest reads the manifest of a results file.
+func LoadManifest(resultsPath string) (RunManifest, error) {
+	var manifest RunManifest
+	data, err := os.ReadFile(ManifestPath(resultsPath))
+	if err != nil {
+		return manifest, err
+	}
+	err = json.Unmarshal(data, &manifest)
+	return manifest, err
+}
@@ -0,0 +1,133 @@
+package bridge
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/config"
+)
+
+func TestNewRunManifestRecordsTheConfiguration(t *testing.T) {
+	run := BenchmarkConfig{
+		Provider:    "openrouter",
+		Model:       "openai/gpt-4o, anthropic/claude-sonnet-4",
+		Madmax:      true,
+		Samples:     10,
+		Tests:       []string{"counter", "effect"},
+		ContextFile: "context/svelte.dev/llms-small.txt",
+		Retry:       config.RetrySettings{BackoffFactor: 1.5},
+	}
+	env := buildBenchmarkEnv([]string{"RETRY_MAX_ATTEMPTS=3", "RETRY_MAX_DELAY_MS=soon"}, run)
+	started := time.Date(2025, 10, 17, 19, 39, 14, 0, time.UTC)
+
+	manifest := newRunManifest(t.TempDir(), run, env, started)
+	if manifest.ExecutionMode != "madmax" || manifest.Samples != 10 || manifest.TestFilter != "counter,effect" {
+		t.Fatalf("unexpected run settings %+v", manifest)
+	}
+	if manifest.ContextFile != "context/svelte.dev/llms-small.txt" {
+		t.Fatalf("expected the context file given to the runner, got %q", manifest.ContextFile)
+	}
+	if len(manifest.Models) != 2 || manifest.Models[1] != "anthropic/claude-sonnet-4" {
+		t.Fatalf("expected the model list split, got %v", manifest.Models)
+	}
+	want := config.RetrySettings{MaxAttempts: 3, InitialDelayMs: 1000, MaxDelayMs: 30000, BackoffFactor: 1.5}
+	if manifest.Retry != want {
+		t.Fatalf("expected overrides, then the environment, then runner defaults, got %+v", manifest.Retry)
+	}
+	if manifest.Git.Commit != "" || manifest.Git.Dirty {
+		t.Fatalf("expected no git state outside a repository, got %+v", manifest.Git)
+	}
+	if manifest.TUIVersion == "" {
+		t.Fatal("expected a TUI version")
+	}
+}
+
+func TestRunManifestFinishRecordsExitStatus(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("needs sh")
+	}
+	runErr := exec.Command("sh", "-c", "exit 7").Run()
+
+	var manifest RunManifest
+	manifest.finish(time.Now(), errors.Join(errors.New("command failed"), runErr))
+	if manifest.ExitStatus != 7 || manifest.Error == "" {
+		t.Fatalf("expected the runner's exit status, got %d %q", manifest.ExitStatus, manifest.Error)
+	}
+
+	manifest = RunManifest{}
+	manifest.finish(time.Now(), errors.New("failed to start command"))
+	if manifest.ExitStatus != -1 {
+		t.Fatalf("expected -1 when the runner did not exit, got %d", manifest.ExitStatus)
+	}
+}
+
+func TestWriteRunManifestsBesideEachResultsFile(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{
+		filepath.Join(dir, "benchmark-results-2025-10-17T19-39-14.181Z.json"),
+		filepath.Join(dir, "benchmark-results-2025-10-17T19-52-01.004Z.json"),
+	}
+	manifest := RunManifest{SchemaVersion: manifestSchemaVersion, Provider: "openai", Samples: 10}
+	if err := writeRunManifests(dir, manifest, files); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadManifest(files[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Provider != "openai" || len(loaded.ResultsFiles) != 2 || loaded.ResultsFiles[0] != filepath.Base(files[0]) {
+		t.Fatalf("unexpected manifest %+v", loaded)
+	}
+	if ManifestPath(files[0]) != filepath.Join(dir, "manifests", "benchmark-results-2025-10-17T19-39-14.181Z.manifest.json") {
+		t.Fatalf("unexpected manifest path %s", ManifestPath(files[0]))
+	}
+
+	// A run that saved nothing is still recorded.
+	empty := t.TempDir()
+	manifest.StartedAt = time.Date(2025, 10, 17, 19, 39, 14, 181e6, time.UTC)
+	if err := writeRunManifests(empty, manifest, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(empty, "manifests", "benchmark-run-2025-10-17T19-39-14.181Z.manifest.json")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRunManifestsStayOutOfTheTypeScriptBuild mirrors the file filters of
+// build-static.ts and merge.ts, which read the top level of benchmarks/.
+func TestRunManifestsStayOutOfTheTypeScriptBuild(t *testing.T) {
+	dir := t.TempDir()
+	resultsFile := filepath.Join(dir, "benchmark-results-2025-10-17T19-39-14.181Z.json")
+	if err := os.WriteFile(resultsFile, []byte("[]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	manifest := RunManifest{SchemaVersion: manifestSchemaVersion, StartedAt: time.Now()}
+	if err := writeRunManifests(dir, manifest, []string{resultsFile}); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeRunManifests(dir, manifest, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamped := regexp.MustCompile(`\d{4}-\d{2}-\d{2}T`)
+	for _, entry := range entries {
+		name := entry.Name()
+		buildStatic := strings.HasSuffix(name, ".json")
+		merge := buildStatic && strings.Contains(name, "benchmark-results") && timestamped.MatchString(name) &&
+			!strings.Contains(name, "with-context") && name != "benchmark-results-merged.json"
+		if (buildStatic || merge) && name != filepath.Base(resultsFile) {
+			t.Errorf("the TypeScript build would load %s as results", name)
+		}
+	}
+}
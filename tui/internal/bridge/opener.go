@@ -0,0 +1,258 @@
+package bridge
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OpenerEnv overrides opener detection with a command such as "firefox" or
+// "code -r". The path is appended, or substituted for %s.
+const OpenerEnv = "TUI_OPENER"
+
+// ReportFileName is the static visualization built by `pnpm build`.
+const ReportFileName = "benchmark-results-merged.html"
+
+// openerGrace is how long an opener may take to fail. Openers that hand the
+// file to a running application exit within it; ones still running after it
+// are assumed to have opened the file.
+const openerGrace = 2 * time.Second
+
+// Opener is a command that opens a file or URL.
+type Opener struct {
+	// Source says where the opener came from: TUI_OPENER, BROWSER or the
+	// platform.
+	Source  string
+	Command []string
+	// URL passes a file:// URL instead of a path, for browsers.
+	URL bool
+}
+
+// Name returns the program the opener runs.
+func (o Opener) Name() string {
+	return filepath.Base(o.Command[0])
+}
+
+// args returns the arguments that open target, substituting %s or appending
+// target.
+func (o Opener) args(target string) []string {
+	args := make([]string, 0, len(o.Command))
+	substituted := false
+	for _, arg := range o.Command[1:] {
+		if strings.Contains(arg, "%s") {
+			arg = strings.ReplaceAll(arg, "%s", target)
+			substituted = true
+		}
+		args = append(args, arg)
+	}
+	if !substituted {
+		args = append(args, target)
+	}
+	return args
+}
+
+// OpenError reports that no opener could open Path. The caller can offer
+// FileURL, or serve the file, instead.
+type OpenError struct {
+	Path string
+	// Failures describe each opener that was tried; none were found when it
+	// is empty.
+	Failures []string
+}
+
+func (e *OpenError) Error() string {
+	if len(e.Failures) == 0 {
+		return fmt.Sprintf("no opener found for %s; install xdg-open or set %s or BROWSER", filepath.Base(e.Path), OpenerEnv)
+	}
+	return fmt.Sprintf("no opener could open %s (%s)", filepath.Base(e.Path), strings.Join(e.Failures, "; "))
+}
+
+// FileURL returns the file:// URL of Path, to open by hand.
+func (e *OpenError) FileURL() string {
+	return FileURL(e.Path)
+}
+
+// FileURL returns the file:// URL of path.
+func FileURL(path string) string {
+	if absolute, err := filepath.Abs(path); err == nil {
+		path = absolute
+	}
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		// Windows drive paths such as C:/benchmarks.
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+// DetectOpeners lists the openers to try, in order. TUI_OPENER replaces
+// detection; otherwise each command in BROWSER is tried before the platform's
+// opener. Only commands found on PATH are listed.
+func DetectOpeners() []Opener {
+	return detectOpeners(runtime.GOOS, os.Getenv, exec.LookPath, isWSL())
+}
+
+func detectOpeners(goos string, getenv func(string) string, lookPath func(string) (string, error), wsl bool) []Opener {
+	var candidates []Opener
+	if override := strings.Fields(getenv(OpenerEnv)); len(override) > 0 {
+		candidates = append(candidates, Opener{Source: OpenerEnv, Command: override})
+	} else {
+		separator := ":"
+		if goos == "windows" {
+			separator = ";"
+		}
+		for _, browser := range strings.Split(getenv("BROWSER"), separator) {
+			if command := strings.Fields(browser); len(command) > 0 {
+				candidates = append(candidates, Opener{Source: "BROWSER", Command: command, URL: true})
+			}
+		}
+		switch goos {
+		case "darwin":
+			candidates = append(candidates, Opener{Source: "platform", Command: []string{"open"}})
+		case "windows":
+			candidates = append(candidates, Opener{Source: "platform", Command: []string{"rundll32", "url.dll,FileProtocolHandler"}})
+		default:
+			if wsl {
+				candidates = append(candidates, Opener{Source: "platform", Command: []string{"wslview"}})
+			}
+			candidates = append(candidates, Opener{Source: "platform", Command: []string{"xdg-open"}})
+		}
+	}
+
+	openers := make([]Opener, 0, len(candidates))
+	for _, opener := range candidates {
+		if _, err := lookPath(opener.Command[0]); err == nil {
+			openers = append(openers, opener)
+		}
+	}
+	return openers
+}
+
+// isWSL reports whether the process runs under the Windows Subsystem for
+// Linux, where wslview opens files in the Windows default application.
+func isWSL() bool {
+	if os.Getenv("WSL_DISTRO_NAME") != "" {
+		return true
+	}
+	version, err := os.ReadFile("/proc/version")
+	return err == nil && bytes.Contains(bytes.ToLower(version), []byte("microsoft"))
+}
+
+// OpenFile opens path with the first detected opener that works, returning an
+// *OpenError when none does.
+func OpenFile(path string) error {
+	return openWith(DetectOpeners(), path, openerGrace)
+}
+
+func openWith(openers []Opener, path string, grace time.Duration) error {
+	var failures []string
+	for _, opener := range openers {
+		target := path
+		if opener.URL {
+			target = FileURL(path)
+		}
+		if err := runOpener(opener, target, grace); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", opener.Name(), err))
+			continue
+		}
+		return nil
+	}
+	return &OpenError{Path: path, Failures: failures}
+}
+
+// runOpener starts opener and waits up to grace for it to fail. The first
+// line of its error output explains a failure better than the exit status.
+func runOpener(opener Opener, target string, grace time.Duration) error {
+	cmd := exec.Command(opener.Command[0], opener.args(target)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err := <-done:
+		if err == nil {
+			return nil
+		}
+		if message, _, _ := strings.Cut(strings.TrimSpace(stderr.String()), "\n"); message != "" {
+			return errors.New(message)
+		}
+		return err
+	case <-time.After(grace):
+		// Still running: the opener is the application itself, such as a
+		// browser from BROWSER. The goroutine reaps it when it exits.
+		return nil
+	}
+}
+
+// OpenResults opens the static visualization in the user's browser. It
+// returns an *OpenError when no opener works, so the caller can offer the
+// file:// URL or ServeReport instead.
+func OpenResults() error {
+	path, err := ReportPath()
+	if err != nil {
+		return err
+	}
+	return OpenFile(path)
+}
+
+// ReportPath returns the path of the static visualization, or an error when it
+// has not been built.
+func ReportPath() (string, error) {
+	dir, err := GetBenchmarksDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get project root: %w", err)
+	}
+	path := filepath.Join(dir, ReportFileName)
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%s has not been built yet; run pnpm build", ReportFileName)
+		}
+		return "", err
+	}
+	return path, nil
+}
+
+var (
+	servedMu   sync.Mutex
+	servedDirs = make(map[string]string)
+)
+
+// ServeReport serves the directory of path on a local port, for machines
+// without a browser opener such as remote shells, and returns the URL of
+// path. The server runs until the process exits; serving the same directory
+// again reuses it.
+func ServeReport(path string) (string, error) {
+	dir := filepath.Dir(path)
+	servedMu.Lock()
+	defer servedMu.Unlock()
+
+	base, ok := servedDirs[dir]
+	if !ok {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return "", fmt.Errorf("failed to serve the report: %w", err)
+		}
+		server := &http.Server{
+			Handler:           http.FileServer(http.Dir(dir)),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go server.Serve(listener)
+		base = "http://" + listener.Addr().String() + "/"
+		servedDirs[dir] = base
+	}
+	return base + url.PathEscape(filepath.Base(path)), nil
+}
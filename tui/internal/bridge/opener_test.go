@@ -0,0 +1,122 @@
+package bridge
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func fakeEnv(values map[string]string) func(string) string {
+	return func(key string) string { return values[key] }
+}
+
+func lookPathIn(available ...string) func(string) (string, error) {
+	return func(name string) (string, error) {
+		for _, candidate := range available {
+			if candidate == name {
+				return "/usr/bin/" + name, nil
+			}
+		}
+		return "", exec.ErrNotFound
+	}
+}
+
+func openerNames(openers []Opener) []string {
+	names := make([]string, len(openers))
+	for i, opener := range openers {
+		names[i] = opener.Name()
+	}
+	return names
+}
+
+func TestDetectOpenersOrdersBrowserBeforePlatform(t *testing.T) {
+	env := fakeEnv(map[string]string{"BROWSER": "firefox %s:w3m"})
+	openers := detectOpeners("linux", env, lookPathIn("firefox", "xdg-open", "wslview"), true)
+	if got := strings.Join(openerNames(openers), ","); got != "firefox,wslview,xdg-open" {
+		t.Fatalf("expected BROWSER then WSL then xdg-open, skipping missing w3m, got %s", got)
+	}
+	if !openers[0].URL || openers[2].URL {
+		t.Fatal("expected only BROWSER entries to receive file URLs")
+	}
+
+	openers = detectOpeners("darwin", fakeEnv(nil), lookPathIn("open"), false)
+	if got := strings.Join(openerNames(openers), ","); got != "open" {
+		t.Fatalf("expected open on macOS, got %s", got)
+	}
+}
+
+func TestDetectOpenersHonoursOverride(t *testing.T) {
+	env := fakeEnv(map[string]string{OpenerEnv: "code -r", "BROWSER": "firefox"})
+	openers := detectOpeners("linux", env, lookPathIn("code", "firefox", "xdg-open"), false)
+	if len(openers) != 1 || openers[0].Source != OpenerEnv {
+		t.Fatalf("expected the override to replace detection, got %+v", openers)
+	}
+	if got := openers[0].args("/tmp/report.html"); strings.Join(got, " ") != "-r /tmp/report.html" {
+		t.Fatalf("expected the path appended, got %v", got)
+	}
+
+	browser := Opener{Command: []string{"firefox", "--new-tab", "%s"}}
+	if got := browser.args("file:///r.html"); strings.Join(got, " ") != "--new-tab file:///r.html" {
+		t.Fatalf("expected %%s substituted, got %v", got)
+	}
+}
+
+func TestOpenWithFallsThroughFailingOpeners(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("needs sh")
+	}
+	failing := Opener{Source: "platform", Command: []string{"sh", "-c", "echo 'no method available' >&2; exit 3", "opener"}}
+	working := Opener{Source: "BROWSER", Command: []string{"sh", "-c", "exit 0", "opener"}}
+
+	if err := openWith([]Opener{failing, working}, "report.html", time.Second); err != nil {
+		t.Fatalf("expected the second opener to succeed, got %v", err)
+	}
+
+	err := openWith([]Opener{failing}, "report.html", time.Second)
+	var openErr *OpenError
+	if !errors.As(err, &openErr) {
+		t.Fatalf("expected an *OpenError, got %v", err)
+	}
+	if got := err.Error(); got != "no opener could open report.html (sh: no method available)" {
+		t.Fatalf("expected the opener's message, got %q", got)
+	}
+	if !strings.HasPrefix(openErr.FileURL(), "file:///") || !strings.HasSuffix(openErr.FileURL(), "/report.html") {
+		t.Fatalf("unexpected file URL %q", openErr.FileURL())
+	}
+
+	err = openWith(nil, "report.html", time.Second)
+	if err == nil || !strings.Contains(err.Error(), "no opener found") {
+		t.Fatalf("expected a hint when no opener exists, got %v", err)
+	}
+}
+
+func TestServeReportServesTheFileLocally(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ReportFileName)
+	if err := os.WriteFile(path, []byte("<h1>results</h1>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := ServeReport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, err := ServeReport(path); err != nil || again != address {
+		t.Fatalf("expected the server to be reused, got %q, %v", again, err)
+	}
+
+	response, err := http.Get(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK || string(body) != "<h1>results</h1>" {
+		t.Fatalf("unexpected response %d %q", response.StatusCode, body)
+	}
+}
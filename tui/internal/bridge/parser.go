@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"path/filepath"
+	"svelte-bench/tui/internal/results"
 )
 
 // EventType represents different event types from the benchmark runner
@@ -34,9 +36,23 @@ type BenchmarkEvent struct {
 	PassAtOne    float64                `json:"passAtOne,omitempty"`
 	PassAtTen    float64                `json:"passAtTen,omitempty"`
 	ResultsSaved string                 `json:"resultsSaved,omitempty"`
+	ResultsFiles []string               `json:"resultsFiles,omitempty"`
 	RawData      map[string]interface{} `json:"-"`
 }
 
+// SavedResultFiles returns the results files a complete event reports. Older
+// runners list no files and send a placeholder in resultsSaved, which is
+// ignored so callers can fall back to finding the files themselves.
+func (e BenchmarkEvent) SavedResultFiles() []string {
+	if len(e.ResultsFiles) > 0 {
+		return e.ResultsFiles
+	}
+	if e.ResultsSaved != "" && results.IsResultsFile(filepath.Base(e.ResultsSaved)) {
+		return []string{e.ResultsSaved}
+	}
+	return nil
+}
+
 // ParseEvents reads and parses events from a reader
 func ParseEvents(reader io.Reader, callback func(BenchmarkEvent)) error {
 	scanner := bufio.NewScanner(reader)
@@ -17,3 +17,26 @@ func TestParseEventsPreservesModelIdentity(t *testing.T) {
 		t.Fatalf("expected model identity to survive event parsing, got %#v", events)
 	}
 }
+
+func TestSavedResultFilesPrefersTheFileList(t *testing.T) {
+	var events []BenchmarkEvent
+	input := `{"type":"complete","resultsSaved":"/b/benchmark-results-2.json","resultsFiles":["/b/benchmark-results-1.json","/b/benchmark-results-2.json"]}
+{"type":"complete","resultsSaved":"/b/benchmark-results-3.json"}
+{"type":"complete","resultsSaved":"benchmark-complete"}
+`
+	if err := ParseEvents(strings.NewReader(input), func(event BenchmarkEvent) {
+		events = append(events, event)
+	}); err != nil {
+		t.Fatalf("ParseEvents returned error: %v", err)
+	}
+
+	if files := events[0].SavedResultFiles(); len(files) != 2 || files[0] != "/b/benchmark-results-1.json" {
+		t.Fatalf("expected every file of the run, got %v", files)
+	}
+	if files := events[1].SavedResultFiles(); len(files) != 1 || files[0] != "/b/benchmark-results-3.json" {
+		t.Fatalf("expected resultsSaved alone to be used, got %v", files)
+	}
+	if files := events[2].SavedResultFiles(); files != nil {
+		t.Fatalf("expected the legacy placeholder to be ignored, got %v", files)
+	}
+}
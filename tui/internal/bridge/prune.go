@@ -0,0 +1,279 @@
+package bridge
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"time"
+)
+
+// ProtectFileName is the file in the benchmarks directory listing results
+// files, or glob patterns relative to the directory, that pruning never
+// touches. Lines starting with # are comments.
+const ProtectFileName = ".prune-protect"
+
+// PruneFilter selects the runs to prune. A run must match every set field;
+// zero values match any run.
+type PruneFilter struct {
+	// Before matches runs dated before it.
+	Before time.Time
+	// MaxSamples matches runs with at most this many samples per test, such
+	// as throwaway debug runs with 1 sample.
+	MaxSamples int
+	// Model matches runs with a provider or model ID containing it, ignoring
+	// case.
+	Model string
+	// Tag matches runs whose file name carries this custom prefix.
+	Tag string
+}
+
+// IsEmpty reports whether the filter matches every run.
+func (f PruneFilter) IsEmpty() bool {
+	return f.Before.IsZero() && f.MaxSamples <= 0 && f.Model == "" && f.Tag == ""
+}
+
+// Matches reports whether run is selected by the filter.
+func (f PruneFilter) Matches(run BenchmarkRun) bool {
+	if !f.Before.IsZero() && !run.Date.Before(f.Before) {
+		return false
+	}
+	if f.MaxSamples > 0 && run.Samples > f.MaxSamples {
+		return false
+	}
+	if f.Model != "" {
+		query := strings.ToLower(f.Model)
+		found := strings.Contains(strings.ToLower(run.Provider), query)
+		for _, model := range run.Models {
+			found = found || strings.Contains(strings.ToLower(model), query)
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Tag != "" && !strings.EqualFold(results.FileTag(run.Path), f.Tag) {
+		return false
+	}
+	return true
+}
+
+// PrunePolicy is a prune request: the runs matching Filter are pruned, except
+// the KeepLatest newest runs of each model and the Protected files.
+type PrunePolicy struct {
+	Filter     PruneFilter
+	KeepLatest int
+	// Protected holds paths or glob patterns, relative to the benchmarks
+	// directory or absolute.
+	Protected []string
+	// IncludeV1 allows pruning the legacy runs in benchmarks/v1, which the
+	// v1 build scripts read.
+	IncludeV1 bool
+}
+
+// PruneDecision records what pruning does with one run matching the filter.
+type PruneDecision struct {
+	Run   BenchmarkRun
+	Prune bool
+	// Reason explains why a matching run is kept.
+	Reason string
+}
+
+// PlanPrune decides which of the runs in dir match the policy and which of
+// those are kept. Baselines and the files listed in the protect file are
+// always kept, and v1 runs unless the policy includes them. Decisions are
+// ordered oldest first; nothing is changed on disk.
+func PlanPrune(dir string, runs []BenchmarkRun, policy PrunePolicy) ([]PruneDecision, error) {
+	baselines, err := LoadBaselines(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", BaselinesFileName, err)
+	}
+	protectFile, err := LoadProtected(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", ProtectFileName, err)
+	}
+	protected := append(protectFile, policy.Protected...)
+	for _, pattern := range protected {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("protected pattern %q: %w", pattern, err)
+		}
+	}
+
+	isBaseline := make(map[string]bool, len(baselines))
+	for _, path := range baselines {
+		isBaseline[filepath.Clean(path)] = true
+	}
+
+	newest := make([]BenchmarkRun, len(runs))
+	copy(newest, runs)
+	SortBenchmarkRuns(newest, SortRunsByDate)
+	latest := make(map[string]bool)
+	kept := make(map[string]int)
+	for _, run := range newest {
+		for _, model := range run.Models {
+			key := strings.ToLower(run.Provider + "/" + model)
+			if kept[key] < policy.KeepLatest {
+				kept[key]++
+				latest[run.Path] = true
+			}
+		}
+	}
+
+	var decisions []PruneDecision
+	for _, run := range newest {
+		if !policy.Filter.Matches(run) {
+			continue
+		}
+		decision := PruneDecision{Run: run, Prune: true}
+		switch {
+		case run.Layout == results.LayoutV1 && !policy.IncludeV1:
+			decision.Prune, decision.Reason = false, "v1"
+		case isBaseline[filepath.Clean(run.Path)]:
+			decision.Prune, decision.Reason = false, "baseline"
+		case matchesProtected(dir, run.Path, protected):
+			decision.Prune, decision.Reason = false, "protected"
+		case latest[run.Path]:
+			decision.Prune, decision.Reason = false, fmt.Sprintf("latest %d of its model", policy.KeepLatest)
+		}
+		decisions = append(decisions, decision)
+	}
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return decisions[i].Run.Date.Before(decisions[j].Run.Date)
+	})
+	return decisions, nil
+}
+
+// LoadProtected reads the protect file of dir. A missing file protects
+// nothing.
+func LoadProtected(dir string) ([]string, error) {
+	file, err := os.Open(filepath.Join(dir, ProtectFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, filepath.FromSlash(line))
+	}
+	return patterns, scanner.Err()
+}
+
+// matchesProtected reports whether path matches a protected pattern, either
+// by its path relative to dir or by its file name.
+func matchesProtected(dir, path string, patterns []string) bool {
+	relative, err := filepath.Rel(dir, path)
+	if err != nil {
+		relative = path
+	}
+	for _, pattern := range patterns {
+		candidates := []string{relative, filepath.Base(path)}
+		if filepath.IsAbs(pattern) {
+			candidates = []string{path}
+		}
+		for _, candidate := range candidates {
+			if matched, _ := filepath.Match(pattern, candidate); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ArchiveRuns writes the files of runs to a new gzip-compressed tar bundle at
+// archivePath, named relative to dir. The bundle is written to a temporary
+// file first so a failed archive never leaves a partial bundle behind, and an
+// existing bundle is never overwritten.
+func ArchiveRuns(archivePath, dir string, runs []BenchmarkRun) error {
+	if _, err := os.Stat(archivePath); err == nil {
+		return fmt.Errorf("%s already exists", archivePath)
+	}
+	temp, err := os.CreateTemp(filepath.Dir(archivePath), ".prune-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	compressed := gzip.NewWriter(temp)
+	bundle := tar.NewWriter(compressed)
+	for _, run := range runs {
+		if err := addToArchive(bundle, dir, run.Path); err != nil {
+			temp.Close()
+			return err
+		}
+		// The run's manifest goes with it, when it has one.
+		manifest := ManifestPath(run.Path)
+		if _, err := os.Stat(manifest); err == nil {
+			if err := addToArchive(bundle, dir, manifest); err != nil {
+				temp.Close()
+				return err
+			}
+		}
+	}
+	if err := bundle.Close(); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := compressed.Close(); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), archivePath)
+}
+
+func addToArchive(bundle *tar.Writer, dir, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	if relative, err := filepath.Rel(dir, path); err == nil && !strings.HasPrefix(relative, "..") {
+		header.Name = filepath.ToSlash(relative)
+	}
+	if err := bundle.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(bundle, file)
+	return err
+}
+
+// DeleteRuns removes the files of runs and their manifests, continuing past
+// failures and returning them joined.
+func DeleteRuns(runs []BenchmarkRun) error {
+	var errs []error
+	for _, run := range runs {
+		if err := os.Remove(run.Path); err != nil {
+			errs = append(errs, err)
+		}
+		if err := os.Remove(ManifestPath(run.Path)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,220 @@
+package bridge
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writePruneFixtures stores one gpt-5 run per day of October 2025, the
+// first two with 1 sample, and returns them newest first.
+func writePruneFixtures(t *testing.T, dir string) []BenchmarkRun {
+	t.Helper()
+	for day := 1; day <= 5; day++ {
+		samples := 10
+		if day <= 2 {
+			samples = 1
+		}
+		tag := ""
+		if day == 3 {
+			tag = "nightly-"
+		}
+		writeRunFixture(t, dir, fmt.Sprintf("benchmark-results-%s2025-10-%02dT08-00-00.000Z.json", tag, day),
+			fmt.Sprintf(`[{"testName":"counter","provider":"OpenAI","modelId":"gpt-5","numSamples":%d,"numCorrect":1,"pass1":0.5,"pass10":1,"samples":[]}]`, samples))
+	}
+	runs, err := ListBenchmarkRuns(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return runs
+}
+
+func prunedNames(decisions []PruneDecision) []string {
+	var names []string
+	for _, decision := range decisions {
+		if decision.Prune {
+			names = append(names, decision.Run.Name())
+		}
+	}
+	return names
+}
+
+func TestPlanPruneKeepsLatestRunsPerModel(t *testing.T) {
+	dir := t.TempDir()
+	runs := writePruneFixtures(t, dir)
+
+	decisions, err := PlanPrune(dir, runs, PrunePolicy{
+		Filter:     PruneFilter{Before: time.Date(2025, time.October, 10, 0, 0, 0, 0, time.UTC)},
+		KeepLatest: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decisions) != 5 {
+		t.Fatalf("expected every run to match, got %d decisions", len(decisions))
+	}
+	pruned := prunedNames(decisions)
+	if len(pruned) != 3 || pruned[0] != "benchmark-results-2025-10-01T08-00-00.000Z.json" {
+		t.Fatalf("expected the three oldest runs pruned oldest first, got %v", pruned)
+	}
+	if last := decisions[4]; last.Prune || last.Reason != "latest 2 of its model" {
+		t.Fatalf("expected the newest run kept as latest, got %+v", last)
+	}
+}
+
+func TestPlanPruneFiltersBySamplesAndTag(t *testing.T) {
+	dir := t.TempDir()
+	runs := writePruneFixtures(t, dir)
+
+	decisions, err := PlanPrune(dir, runs, PrunePolicy{Filter: PruneFilter{MaxSamples: 1, Model: "GPT"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned := prunedNames(decisions); len(pruned) != 2 {
+		t.Fatalf("expected the two 1-sample runs pruned, got %v", pruned)
+	}
+
+	decisions, err = PlanPrune(dir, runs, PrunePolicy{Filter: PruneFilter{Tag: "nightly"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned := prunedNames(decisions); len(pruned) != 1 || pruned[0] != "benchmark-results-nightly-2025-10-03T08-00-00.000Z.json" {
+		t.Fatalf("expected only the tagged run pruned, got %v", pruned)
+	}
+
+	decisions, err = PlanPrune(dir, runs, PrunePolicy{Filter: PruneFilter{Model: "claude"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decisions) != 0 {
+		t.Fatalf("expected no run to match another model, got %d", len(decisions))
+	}
+}
+
+func TestPlanPruneNeverTouchesProtectedRuns(t *testing.T) {
+	dir := t.TempDir()
+	runs := writePruneFixtures(t, dir)
+	if err := SetBaseline(dir, runs[4]); err != nil {
+		t.Fatal(err)
+	}
+	writeRunFixture(t, dir, ProtectFileName, "# keep the tagged run\n*nightly*\n")
+
+	decisions, err := PlanPrune(dir, runs, PrunePolicy{
+		Filter:    PruneFilter{Model: "gpt-5"},
+		Protected: []string{"benchmark-results-2025-10-02T08-00-00.000Z.json"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reasons := make(map[string]string)
+	for _, decision := range decisions {
+		reasons[decision.Run.Name()] = decision.Reason
+	}
+	if reasons[runs[4].Name()] != "baseline" {
+		t.Fatalf("expected the baseline kept, got %q", reasons[runs[4].Name()])
+	}
+	if reasons["benchmark-results-nightly-2025-10-03T08-00-00.000Z.json"] != "protected" ||
+		reasons["benchmark-results-2025-10-02T08-00-00.000Z.json"] != "protected" {
+		t.Fatalf("expected protect file and flag patterns honoured, got %v", reasons)
+	}
+	if pruned := prunedNames(decisions); len(pruned) != 2 {
+		t.Fatalf("expected two unprotected runs pruned, got %v", pruned)
+	}
+
+	if _, err := PlanPrune(dir, runs, PrunePolicy{Protected: []string{"["}}); err == nil {
+		t.Fatal("expected a malformed protected pattern to be rejected")
+	}
+}
+
+func TestPlanPruneKeepsV1RunsUnlessIncluded(t *testing.T) {
+	dir := t.TempDir()
+	writePruneFixtures(t, dir)
+	if err := os.Mkdir(filepath.Join(dir, "v1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	legacy := filepath.Join("v1", "v1-benchmark-results-2025-05-03T19-47-53.331Z.json")
+	writeRunFixture(t, dir, legacy,
+		`[{"testName":"counter","provider":"OpenAI","modelId":"gpt-4","numSamples":10,"numCorrect":1,"pass1":0.1,"pass10":1,"samples":[]}]`)
+	runs, err := ListBenchmarkRuns(dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := PrunePolicy{Filter: PruneFilter{Before: time.Date(2025, time.October, 3, 0, 0, 0, 0, time.UTC)}}
+
+	decisions, err := PlanPrune(dir, runs, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decisions) != 3 || decisions[0].Prune || decisions[0].Reason != "v1" {
+		t.Fatalf("expected the v1 run kept by default, got %+v", decisions)
+	}
+
+	policy.IncludeV1 = true
+	decisions, err = PlanPrune(dir, runs, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned := prunedNames(decisions); len(pruned) != 3 || pruned[0] != filepath.Base(legacy) {
+		t.Fatalf("expected the v1 run pruned when included, got %v", pruned)
+	}
+}
+
+func TestArchiveRunsWritesBundleBeforeDelete(t *testing.T) {
+	dir := t.TempDir()
+	runs := writePruneFixtures(t, dir)
+	archive := filepath.Join(t.TempDir(), "old-runs.tar.gz")
+	manifest := ManifestPath(runs[3].Path)
+	if err := os.MkdirAll(filepath.Dir(manifest), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(manifest, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ArchiveRuns(archive, dir, runs[3:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := ArchiveRuns(archive, dir, runs[3:]); err == nil {
+		t.Fatal("expected an existing archive not to be overwritten")
+	}
+
+	file, err := os.Open(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	compressed, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle := tar.NewReader(compressed)
+	var names []string
+	for {
+		header, err := bundle.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+	}
+	if len(names) != 3 || names[0] != runs[3].Name() || names[1] != "manifests/"+filepath.Base(manifest) {
+		t.Fatalf("expected both runs and the manifest archived by relative name, got %v", names)
+	}
+
+	if err := DeleteRuns(runs[3:]); err != nil {
+		t.Fatal(err)
+	}
+	remaining, err := ListBenchmarkRuns(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 3 {
+		t.Fatalf("expected 3 runs left after delete, got %d", len(remaining))
+	}
+	if _, err := os.Stat(manifest); !os.IsNotExist(err) {
+		t.Fatalf("expected the manifest deleted with its run, got %v", err)
+	}
+}
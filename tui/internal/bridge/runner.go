@@ -1,6 +1,7 @@
 package bridge
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -9,22 +10,31 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"svelte-bench/tui/internal/config"
+	"time"
 )
 
 const debugLogEnv = "TUI_DEBUG_LOG"
 
 // BenchmarkConfig holds the configuration for running a benchmark
 type BenchmarkConfig struct {
-	Provider    string
-	Model       string
-	APIKeys     map[string]string
-	Parallel    bool
-	Madmax      bool
-	Samples     int
+	Provider string
+	Model    string
+	APIKeys  map[string]string
+	Parallel bool
+	Madmax   bool
+	Samples  int
+	// Tests limits the run to these test categories; empty keeps DEBUG_TEST
+	// from the environment, or runs every test.
+	Tests       []string
 	ContextFile string
+	// Retry overrides the runner's retry options; zero fields keep the
+	// RETRY_* environment or the runner's defaults.
+	Retry config.RetrySettings
 }
 
 // RunBenchmark runs the TypeScript benchmark with the given configuration
+// and writes a RunManifest next to each results file it saves.
 func RunBenchmark(config BenchmarkConfig, eventHandler EventHandler) error {
 	// Get project root
 	projectRoot, err := getProjectRoot()
@@ -32,6 +42,45 @@ func RunBenchmark(config BenchmarkConfig, eventHandler EventHandler) error {
 		return fmt.Errorf("failed to get project root: %w", err)
 	}
 
+	// Set environment variables, replacing inherited values rather than
+	// appending duplicates. This guarantees the TUI's selected key wins.
+	env := buildBenchmarkEnv(os.Environ(), config)
+
+	// Provenance is captured before the run, so the results files it writes
+	// don't count as uncommitted changes.
+	started := time.Now()
+	manifest := newRunManifest(projectRoot, config, env, started)
+	var saved []string
+	seenTests := make(map[string]bool)
+	runErr := runBenchmark(projectRoot, env, config, func(event BenchmarkEvent) {
+		switch event.Type {
+		case EventTestStart:
+			if event.Test != "" && !seenTests[event.Test] {
+				seenTests[event.Test] = true
+				manifest.Tests = append(manifest.Tests, event.Test)
+			}
+		case EventComplete:
+			saved = event.SavedResultFiles()
+		}
+		if eventHandler != nil {
+			eventHandler(event)
+		}
+	})
+	manifest.finish(time.Now(), runErr)
+
+	dir := filepath.Join(projectRoot, "benchmarks")
+	if len(saved) == 0 {
+		// Older runners don't list their files; a failed run may still have
+		// saved some models.
+		saved, _ = RunResultFiles(dir, started)
+	}
+	if err := writeRunManifests(dir, manifest, saved); err != nil {
+		return errors.Join(runErr, fmt.Errorf("failed to write run manifest: %w", err))
+	}
+	return runErr
+}
+
+func runBenchmark(projectRoot string, env []string, config BenchmarkConfig, eventHandler EventHandler) error {
 	// Debug logging is opt-in because the log is only useful when diagnosing a
 	// benchmark run and otherwise leaves an untracked file in the project root.
 	var debugLog *os.File
@@ -60,9 +109,6 @@ func RunBenchmark(config BenchmarkConfig, eventHandler EventHandler) error {
 		fmt.Fprintf(debugLog, "Working directory: %s\n\n", projectRoot)
 	}
 
-	// Set environment variables, replacing inherited values rather than
-	// appending duplicates. This guarantees the TUI's selected key wins.
-	env := buildBenchmarkEnv(os.Environ(), config)
 	for key := range config.APIKeys {
 		if debugLog != nil {
 			// Don't log the actual key value, just that it was set
@@ -144,9 +190,7 @@ func RunBenchmark(config BenchmarkConfig, eventHandler EventHandler) error {
 			fmt.Fprintf(debugLog, "EVENT %d: Type=%s, Test=%s, Sample=%d, Total=%d\n",
 				eventCount, event.Type, event.Test, event.Sample, event.Total)
 		}
-		if eventHandler != nil {
-			eventHandler(event)
-		}
+		eventHandler(event)
 	}
 
 	if debugLog != nil {
@@ -197,19 +241,6 @@ func debugLogEnabled() bool {
 	return err == nil && enabled
 }
 
-// OpenResults opens the generated benchmark visualization in the user's
-// default browser.
-func OpenResults() error {
-	projectRoot, err := getProjectRoot()
-	if err != nil {
-		return fmt.Errorf("failed to get project root: %w", err)
-	}
-
-	cmd := exec.Command("pnpm", "open")
-	cmd.Dir = projectRoot
-	return cmd.Run()
-}
-
 func buildBenchmarkEnv(base []string, config BenchmarkConfig) []string {
 	values := make(map[string]string, len(base)+len(config.APIKeys)+5)
 	for _, entry := range base {
@@ -226,6 +257,24 @@ func buildBenchmarkEnv(base []string, config BenchmarkConfig) []string {
 	values["DEBUG_PROVIDER"] = config.Provider
 	values["DEBUG_MODEL"] = config.Model
 	values["DEBUG_SAMPLES"] = fmt.Sprintf("%d", config.Samples)
+	if len(config.Tests) > 0 {
+		values["DEBUG_TEST"] = strings.Join(config.Tests, ",")
+	}
+	if config.ContextFile != "" {
+		values["CONTEXT_FILE"] = config.ContextFile
+	}
+	if config.Retry.MaxAttempts > 0 {
+		values["RETRY_MAX_ATTEMPTS"] = strconv.Itoa(config.Retry.MaxAttempts)
+	}
+	if config.Retry.InitialDelayMs > 0 {
+		values["RETRY_INITIAL_DELAY_MS"] = strconv.Itoa(config.Retry.InitialDelayMs)
+	}
+	if config.Retry.MaxDelayMs > 0 {
+		values["RETRY_MAX_DELAY_MS"] = strconv.Itoa(config.Retry.MaxDelayMs)
+	}
+	if config.Retry.BackoffFactor > 0 {
+		values["RETRY_BACKOFF_FACTOR"] = strconv.FormatFloat(config.Retry.BackoffFactor, 'f', -1, 64)
+	}
 	if config.Madmax {
 		values["MADMAX_EXECUTION"] = "true"
 		delete(values, "PARALLEL_EXECUTION")
@@ -3,6 +3,8 @@ package bridge
 import (
 	"strings"
 	"testing"
+
+	"svelte-bench/tui/internal/config"
 )
 
 func TestDebugLogEnabled(t *testing.T) {
@@ -81,3 +83,32 @@ func TestBuildBenchmarkEnvEnablesMadmaxOnly(t *testing.T) {
 		t.Fatal("expected madmax mode to remove parallel flag")
 	}
 }
+
+func TestBuildBenchmarkEnvPassesTestsContextAndRetry(t *testing.T) {
+	env := buildBenchmarkEnv(
+		[]string{"DEBUG_TEST=hello-world", "RETRY_MAX_DELAY_MS=60000"},
+		BenchmarkConfig{
+			Samples:     3,
+			Tests:       []string{"counter", "effect"},
+			ContextFile: "context/llms-small.txt",
+			Retry:       config.RetrySettings{MaxAttempts: 8, BackoffFactor: 1.5},
+		},
+	)
+
+	values := make(map[string]string)
+	for _, entry := range env {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			values[key] = value
+		}
+	}
+
+	if values["DEBUG_TEST"] != "counter,effect" || values["CONTEXT_FILE"] != "context/llms-small.txt" {
+		t.Fatalf("expected the selected tests and context file, got %q and %q", values["DEBUG_TEST"], values["CONTEXT_FILE"])
+	}
+	if values["RETRY_MAX_ATTEMPTS"] != "8" || values["RETRY_BACKOFF_FACTOR"] != "1.5" {
+		t.Fatalf("expected the retry overrides, got %v", values)
+	}
+	if values["RETRY_MAX_DELAY_MS"] != "60000" {
+		t.Fatal("expected unset retry options to keep the inherited value")
+	}
+}
@@ -0,0 +1,157 @@
+package bridge
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// suiteFiles are the files of a test category that decide its score: the
+// prompt the model answers and the tests its code must pass. Reference
+// implementations are left out because they are not scored against.
+var suiteFiles = []string{"prompt.md", "test.ts"}
+
+// SuiteFingerprint identifies the version of the test suite a run was scored
+// against, as content hashes of each test category and of the whole suite.
+type SuiteFingerprint struct {
+	Hash  string            `json:"hash"`
+	Tests map[string]string `json:"tests"`
+}
+
+// FingerprintSuite hashes the test categories in dir. Line endings are
+// normalised so checkouts on Windows fingerprint the same as elsewhere.
+func FingerprintSuite(dir string) (SuiteFingerprint, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return SuiteFingerprint{}, err
+	}
+
+	fingerprint := SuiteFingerprint{Tests: make(map[string]string)}
+	suite := sha256.New()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		test := sha256.New()
+		found := false
+		for _, name := range suiteFiles {
+			data, err := os.ReadFile(filepath.Join(dir, entry.Name(), name))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return SuiteFingerprint{}, err
+			}
+			data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+			fmt.Fprintf(test, "%s\x00%d\x00", name, len(data))
+			test.Write(data)
+			found = true
+		}
+		if !found {
+			continue
+		}
+		hash := hex.EncodeToString(test.Sum(nil))
+		fingerprint.Tests[entry.Name()] = hash
+		// ReadDir sorts entries, so the suite hash does not depend on the
+		// file system's order.
+		fmt.Fprintf(suite, "%s\x00%s\n", entry.Name(), hash)
+	}
+	if len(fingerprint.Tests) == 0 {
+		return SuiteFingerprint{}, fmt.Errorf("no tests found in %s", dir)
+	}
+	fingerprint.Hash = hex.EncodeToString(suite.Sum(nil))
+	return fingerprint, nil
+}
+
+// ChangedTests returns the tests that differ between two fingerprints,
+// including ones only one of them has, sorted by name.
+func (f SuiteFingerprint) ChangedTests(other SuiteFingerprint) []string {
+	var changed []string
+	for name, hash := range f.Tests {
+		if other.Tests[name] != hash {
+			changed = append(changed, name)
+		}
+	}
+	for name := range other.Tests {
+		if _, ok := f.Tests[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// maxUnfingerprintedShown caps the files SuiteWarning names as lacking a
+// fingerprint.
+const maxUnfingerprintedShown = 3
+
+// runSuite returns the fingerprints of the tests a run covered, from the
+// manifest of its results file, or nil when the run records none. Manifests
+// that do not list the started tests count as covering the whole suite.
+func runSuite(resultsPath string) map[string]string {
+	manifest, err := LoadManifest(resultsPath)
+	if err != nil || manifest.Suite == nil || manifest.Suite.Hash == "" {
+		return nil
+	}
+	if len(manifest.Tests) == 0 {
+		return manifest.Suite.Tests
+	}
+	covered := make(map[string]string, len(manifest.Tests))
+	for _, name := range manifest.Tests {
+		if hash, ok := manifest.Suite.Tests[name]; ok {
+			covered[name] = hash
+		}
+	}
+	return covered
+}
+
+// SuiteWarning returns a warning when runs in paths were scored against
+// different versions of a test they both ran, naming those tests, and when
+// some of them record no fingerprint so such changes cannot be detected. It
+// returns "" for fewer than two files or when nothing is in doubt.
+func SuiteWarning(paths []string) string {
+	if len(paths) < 2 {
+		return ""
+	}
+	var suites []map[string]string
+	var unknown []string
+	for _, path := range paths {
+		suite := runSuite(path)
+		if suite == nil {
+			unknown = append(unknown, filepath.Base(path))
+			continue
+		}
+		suites = append(suites, suite)
+	}
+
+	var changed []string
+	for i, suite := range suites {
+		for _, other := range suites[i+1:] {
+			for name, hash := range suite {
+				if otherHash, ok := other[name]; ok && otherHash != hash && !slices.Contains(changed, name) {
+					changed = append(changed, name)
+				}
+			}
+		}
+	}
+	slices.Sort(changed)
+
+	var warnings []string
+	if len(changed) > 0 {
+		warnings = append(warnings, "Runs were scored against different versions of tests they share: "+strings.Join(changed, ", "))
+	}
+	if len(unknown) > 0 {
+		names := strings.Join(unknown[:min(len(unknown), maxUnfingerprintedShown)], ", ")
+		if len(unknown) > maxUnfingerprintedShown {
+			names += fmt.Sprintf(" and %d more", len(unknown)-maxUnfingerprintedShown)
+		}
+		warnings = append(warnings, fmt.Sprintf("%d of %d results files have no test-suite fingerprint, so changed tests cannot be detected (%s)",
+			len(unknown), len(paths), names))
+	}
+	return strings.Join(warnings, "; ")
+}
@@ -0,0 +1,119 @@
+package bridge
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSuite(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFingerprintSuiteHashesPromptsAndTests(t *testing.T) {
+	dir := t.TempDir()
+	writeSuite(t, dir, map[string]string{
+		"counter/prompt.md":        "Build a counter.\n",
+		"counter/test.ts":          "expect(count).toBe(1)\n",
+		"counter/Reference.svelte": "<script></script>\n",
+		"effect/prompt.md":         "Use $effect.\n",
+		"effect/test.ts":           "expect(log).toEqual([])\n",
+	})
+	before, err := FingerprintSuite(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before.Tests) != 2 || len(before.Hash) != 64 {
+		t.Fatalf("unexpected fingerprint %+v", before)
+	}
+
+	// Reference implementations and line endings don't change the suite.
+	writeSuite(t, dir, map[string]string{
+		"counter/Reference.svelte": "<script>let count = 0;</script>\n",
+		"effect/prompt.md":         "Use $effect.\r\n",
+	})
+	same, err := FingerprintSuite(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same.Hash != before.Hash {
+		t.Fatal("expected references and CRLF line endings to be ignored")
+	}
+
+	writeSuite(t, dir, map[string]string{
+		"effect/test.ts":   "expect(log).toEqual(['mounted'])\n",
+		"snippets/test.ts": "expect(true).toBe(true)\n",
+	})
+	after, err := FingerprintSuite(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Hash == before.Hash || after.Tests["counter"] != before.Tests["counter"] {
+		t.Fatalf("expected only the changed tests to differ, got %+v", after)
+	}
+	if got := strings.Join(before.ChangedTests(after), ","); got != "effect,snippets" {
+		t.Fatalf("expected the stricter and the new test, got %s", got)
+	}
+
+	if _, err := FingerprintSuite(t.TempDir()); err == nil {
+		t.Fatal("expected an error for a directory without tests")
+	}
+}
+
+func TestSuiteWarningComparesSharedTests(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, manifest *RunManifest) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("[]"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if manifest != nil {
+			data, err := json.Marshal(manifest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.MkdirAll(filepath.Dir(ManifestPath(path)), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(ManifestPath(path), data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return path
+	}
+	v1 := &SuiteFingerprint{Hash: "aaaaaaaaaaaaaaaa", Tests: map[string]string{"counter": "1", "effect": "2"}}
+	v2 := &SuiteFingerprint{Hash: "bbbbbbbbbbbbbbbb", Tests: map[string]string{"counter": "1", "effect": "3"}}
+	old := write("benchmark-results-2025-10-01T00-00-00.000Z.json", &RunManifest{Suite: v1})
+	same := write("benchmark-results-2025-10-02T00-00-00.000Z.json", &RunManifest{Suite: v1, Tests: []string{"counter", "effect"}})
+	stricter := write("benchmark-results-2025-11-01T00-00-00.000Z.json", &RunManifest{Suite: v2})
+	counterOnly := write("benchmark-results-2025-11-02T00-00-00.000Z.json", &RunManifest{Suite: v2, Tests: []string{"counter"}})
+	legacy := write("benchmark-results-2025-05-01T00-00-00.000Z.json", nil)
+
+	if got := SuiteWarning([]string{old, same}); got != "" {
+		t.Fatalf("expected no warning for the same suite, got %q", got)
+	}
+	if got := SuiteWarning([]string{legacy}); got != "" {
+		t.Fatalf("expected no warning for a single run, got %q", got)
+	}
+	if got := SuiteWarning([]string{old, stricter}); got != "Runs were scored against different versions of tests they share: effect" {
+		t.Fatalf("unexpected warning %q", got)
+	}
+	if got := SuiteWarning([]string{old, counterOnly}); got != "" {
+		t.Fatalf("expected no warning about a test one run never ran, got %q", got)
+	}
+	want := "1 of 2 results files have no test-suite fingerprint, so changed tests cannot be detected (benchmark-results-2025-05-01T00-00-00.000Z.json)"
+	if got := SuiteWarning([]string{legacy, stricter}); got != want {
+		t.Fatalf("expected the run without a fingerprint to be named, got %q", got)
+	}
+}
@@ -0,0 +1,350 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"svelte-bench/tui/internal/styles"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// RowStatus classifies a table row for status filtering.
+type RowStatus int
+
+const (
+	RowPassing RowStatus = iota
+	RowWarning
+	RowFailing
+)
+
+// StatusFilter selects the rows a table shows by status.
+type StatusFilter int
+
+const (
+	ShowAllRows StatusFilter = iota
+	ShowFailingRows
+	ShowWarningRows
+	ShowPassingRows
+)
+
+func (f StatusFilter) String() string {
+	switch f {
+	case ShowFailingRows:
+		return "failing"
+	case ShowWarningRows:
+		return "warning"
+	case ShowPassingRows:
+		return "passing"
+	}
+	return "all"
+}
+
+func (f StatusFilter) matches(status RowStatus) bool {
+	switch f {
+	case ShowFailingRows:
+		return status == RowFailing
+	case ShowWarningRows:
+		return status == RowWarning
+	case ShowPassingRows:
+		return status == RowPassing
+	}
+	return true
+}
+
+// TableColumn describes one column of a Table.
+type TableColumn[T any] struct {
+	Title string
+	Width int
+	Align lipgloss.Position
+	// Render returns the styled cell of row; the table pads and truncates it
+	// to Width.
+	Render func(row T) string
+	// Less orders rows ascending by this column. Columns without it cannot be
+	// sorted by.
+	Less func(a, b T) bool
+	// SortDescending makes NextSort put the largest value first, as for
+	// scores.
+	SortDescending bool
+}
+
+// TableKeyMap binds the keys a Table handles in Update.
+type TableKeyMap struct {
+	LineUp   []string
+	LineDown []string
+	PageUp   []string
+	PageDown []string
+	Top      []string
+	Bottom   []string
+	Sort     []string
+	Reverse  []string
+	Filter   []string
+}
+
+// DefaultTableKeyMap leaves the arrow keys to the screen around the table,
+// which usually moves a menu selection with them.
+func DefaultTableKeyMap() TableKeyMap {
+	return TableKeyMap{
+		LineUp:   []string{"shift+up"},
+		LineDown: []string{"shift+down"},
+		PageUp:   []string{"pgup"},
+		PageDown: []string{"pgdown"},
+		Top:      []string{"home"},
+		Bottom:   []string{"end"},
+		Sort:     []string{"tab"},
+		Reverse:  []string{"shift+tab"},
+		Filter:   []string{"v"},
+	}
+}
+
+// Table renders rows under a frozen header, with keyboard scrolling, column
+// sorting and status filtering. Rows keep their input order until a column is
+// sorted by.
+type Table[T any] struct {
+	Columns []TableColumn[T]
+	// Status classifies rows for filtering; without it every row passes.
+	Status func(row T) RowStatus
+	KeyMap TableKeyMap
+
+	rows       []T
+	visible    []T
+	sortColumn int
+	descending bool
+	filter     StatusFilter
+	offset     int
+	height     int
+}
+
+// NewTable creates a table showing 10 rows at a time.
+func NewTable[T any](columns []TableColumn[T], status func(row T) RowStatus) Table[T] {
+	return Table[T]{
+		Columns:    columns,
+		Status:     status,
+		KeyMap:     DefaultTableKeyMap(),
+		sortColumn: -1,
+		height:     10,
+	}
+}
+
+// SetRows replaces the rows, keeping the sort order, filter and, where
+// possible, the scroll position.
+func (t *Table[T]) SetRows(rows []T) {
+	t.rows = rows
+	t.refresh()
+}
+
+// SetColumns replaces the columns, keeping the sort order when a column with
+// the same title is still sortable.
+func (t *Table[T]) SetColumns(columns []TableColumn[T]) {
+	sortTitle := ""
+	if t.sortColumn >= 0 {
+		sortTitle = t.Columns[t.sortColumn].Title
+	}
+	t.Columns = columns
+	t.sortColumn = -1
+	for i, column := range columns {
+		if sortTitle != "" && column.Title == sortTitle && column.Less != nil {
+			t.sortColumn = i
+		}
+	}
+	t.refresh()
+}
+
+// SetHeight sets the number of rows shown below the header.
+func (t *Table[T]) SetHeight(height int) {
+	t.height = max(1, height)
+	t.clamp()
+}
+
+// Height returns the number of rows shown below the header.
+func (t Table[T]) Height() int {
+	return t.height
+}
+
+// Rows returns the rows that pass the filter, in display order.
+func (t Table[T]) Rows() []T {
+	return t.visible
+}
+
+// SortBy orders rows by column, or restores the input order when column is
+// -1. Columns that cannot be sorted by are ignored.
+func (t *Table[T]) SortBy(column int, descending bool) {
+	if column >= 0 && (column >= len(t.Columns) || t.Columns[column].Less == nil) {
+		return
+	}
+	t.sortColumn, t.descending = column, descending
+	t.refresh()
+}
+
+// SortColumn returns the column rows are sorted by, or -1.
+func (t Table[T]) SortColumn() int {
+	return t.sortColumn
+}
+
+// NextSort sorts by the next sortable column, in its default direction, and
+// returns to the input order after the last one.
+func (t *Table[T]) NextSort() {
+	for column := t.sortColumn + 1; column < len(t.Columns); column++ {
+		if t.Columns[column].Less != nil {
+			t.SortBy(column, t.Columns[column].SortDescending)
+			return
+		}
+	}
+	t.SortBy(-1, false)
+}
+
+// Reverse flips the sort order.
+func (t *Table[T]) Reverse() {
+	t.descending = !t.descending
+	t.refresh()
+}
+
+// SetFilter shows only the rows with the status selected by filter.
+func (t *Table[T]) SetFilter(filter StatusFilter) {
+	t.filter = filter
+	t.offset = 0
+	t.refresh()
+}
+
+// NextFilter cycles through all, failing, warning and passing rows.
+func (t *Table[T]) NextFilter() {
+	t.SetFilter((t.filter + 1) % (ShowPassingRows + 1))
+}
+
+// Filter returns the status filter.
+func (t Table[T]) Filter() StatusFilter {
+	return t.filter
+}
+
+// Scroll moves the view by delta rows.
+func (t *Table[T]) Scroll(delta int) {
+	t.offset += delta
+	t.clamp()
+}
+
+// Update handles the keys of the key map. handled is false for other keys,
+// which the caller handles.
+func (t Table[T]) Update(msg tea.KeyPressMsg) (table Table[T], handled bool) {
+	key := msg.String()
+	switch {
+	case slices.Contains(t.KeyMap.LineUp, key):
+		t.Scroll(-1)
+	case slices.Contains(t.KeyMap.LineDown, key):
+		t.Scroll(1)
+	case slices.Contains(t.KeyMap.PageUp, key):
+		t.Scroll(-t.height)
+	case slices.Contains(t.KeyMap.PageDown, key):
+		t.Scroll(t.height)
+	case slices.Contains(t.KeyMap.Top, key):
+		t.Scroll(-len(t.visible))
+	case slices.Contains(t.KeyMap.Bottom, key):
+		t.Scroll(len(t.visible))
+	case slices.Contains(t.KeyMap.Sort, key):
+		t.NextSort()
+	case slices.Contains(t.KeyMap.Reverse, key):
+		t.Reverse()
+	case slices.Contains(t.KeyMap.Filter, key):
+		t.NextFilter()
+	default:
+		return t, false
+	}
+	return t, true
+}
+
+func (t *Table[T]) refresh() {
+	// A fresh slice keeps copies of the table, such as a screen kept to go
+	// back to, from sharing the order.
+	t.visible = make([]T, 0, len(t.rows))
+	for _, row := range t.rows {
+		if t.Status == nil || t.filter.matches(t.Status(row)) {
+			t.visible = append(t.visible, row)
+		}
+	}
+	if t.sortColumn >= 0 {
+		less := t.Columns[t.sortColumn].Less
+		slices.SortStableFunc(t.visible, func(a, b T) int {
+			if t.descending {
+				a, b = b, a
+			}
+			switch {
+			case less(a, b):
+				return -1
+			case less(b, a):
+				return 1
+			}
+			return 0
+		})
+	}
+	t.clamp()
+}
+
+func (t *Table[T]) clamp() {
+	t.offset = max(0, min(t.offset, len(t.visible)-t.height))
+}
+
+// View renders the header, the rows in view and a status line with the
+// scroll position, sort order and filter.
+func (t Table[T]) View() string {
+	header := make([]string, len(t.Columns))
+	for i, column := range t.Columns {
+		title := column.Title
+		if i == t.sortColumn {
+			if t.descending {
+				title += "↓"
+			} else {
+				title += "↑"
+			}
+		}
+		header[i] = t.cell(column, title)
+	}
+	lines := []string{styles.SectionLabelStyle.Render("  " + strings.Join(header, " "))}
+
+	end := min(t.offset+t.height, len(t.visible))
+	for _, row := range t.visible[t.offset:end] {
+		cells := make([]string, len(t.Columns))
+		for i, column := range t.Columns {
+			cells[i] = t.cell(column, column.Render(row))
+		}
+		lines = append(lines, "  "+strings.Join(cells, " "))
+	}
+	if len(t.visible) == 0 {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayMedium).
+			Render(fmt.Sprintf("  No %s rows", t.filter)))
+	}
+
+	var status []string
+	if len(t.visible) > t.height {
+		status = append(status, fmt.Sprintf("rows %d–%d of %d", t.offset+1, end, len(t.visible)))
+		if end < len(t.visible) {
+			status = append(status, fmt.Sprintf("↓ %d more", len(t.visible)-end))
+		}
+	}
+	if t.sortColumn >= 0 {
+		status = append(status, "sorted by "+strings.ToLower(t.Columns[t.sortColumn].Title))
+	}
+	if t.filter != ShowAllRows {
+		status = append(status, fmt.Sprintf("%s only (%d of %d)", t.filter, len(t.visible), len(t.rows)))
+	}
+	if len(status) > 0 {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render("  "+strings.Join(status, " • ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (t Table[T]) cell(column TableColumn[T], content string) string {
+	width := column.Width
+	if column.Less != nil {
+		// Room for the sort arrow after the title.
+		width = max(width, ansi.StringWidth(column.Title)+1)
+	}
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(column.Align).
+		Render(ansi.Truncate(content, width, "…"))
+}
@@ -0,0 +1,200 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+type scoreRow struct {
+	name  string
+	score int
+}
+
+func scoreColumns() []TableColumn[scoreRow] {
+	return []TableColumn[scoreRow]{
+		{
+			Title:  "NAME",
+			Width:  8,
+			Render: func(row scoreRow) string { return row.name },
+			Less:   func(a, b scoreRow) bool { return a.name < b.name },
+		},
+		{
+			Title:          "SCORE",
+			Width:          5,
+			Render:         func(row scoreRow) string { return fmt.Sprint(row.score) },
+			Less:           func(a, b scoreRow) bool { return a.score < b.score },
+			SortDescending: true,
+		},
+		{
+			Title:  "NOTE",
+			Width:  6,
+			Render: func(scoreRow) string { return "-" },
+		},
+	}
+}
+
+func scoreStatus(row scoreRow) RowStatus {
+	switch {
+	case row.score < 50:
+		return RowFailing
+	case row.score < 70:
+		return RowWarning
+	}
+	return RowPassing
+}
+
+func scoreTable(height int) Table[scoreRow] {
+	table := NewTable(scoreColumns(), scoreStatus)
+	table.SetRows([]scoreRow{
+		{"carol", 40}, {"alice", 90}, {"dave", 60}, {"bob", 75}, {"erin", 10},
+	})
+	table.SetHeight(height)
+	return table
+}
+
+func rowNames(rows []scoreRow) string {
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row.name
+	}
+	return strings.Join(names, ",")
+}
+
+func TestTableSortCyclesThroughSortableColumns(t *testing.T) {
+	table := scoreTable(10)
+	if got := rowNames(table.Rows()); got != "carol,alice,dave,bob,erin" {
+		t.Fatalf("rows = %s, want the input order", got)
+	}
+
+	table.NextSort()
+	if got := rowNames(table.Rows()); table.SortColumn() != 0 || got != "alice,bob,carol,dave,erin" {
+		t.Errorf("sorted by %d: %s, want by name", table.SortColumn(), got)
+	}
+	table.NextSort()
+	if got := rowNames(table.Rows()); table.SortColumn() != 1 || got != "alice,bob,dave,carol,erin" {
+		t.Errorf("sorted by %d: %s, want by score, highest first", table.SortColumn(), got)
+	}
+	table.Reverse()
+	if got := rowNames(table.Rows()); got != "erin,carol,dave,bob,alice" {
+		t.Errorf("reversed: %s", got)
+	}
+	// NOTE has no Less, so the next sort restores the input order.
+	table.NextSort()
+	if got := rowNames(table.Rows()); table.SortColumn() != -1 || got != "carol,alice,dave,bob,erin" {
+		t.Errorf("sorted by %d: %s, want the input order", table.SortColumn(), got)
+	}
+
+	table.SortBy(2, false)
+	if table.SortColumn() != -1 {
+		t.Error("SortBy accepted a column that cannot be sorted by")
+	}
+}
+
+func TestTableSortSurvivesNewRowsAndColumns(t *testing.T) {
+	table := scoreTable(10)
+	table.SortBy(1, true)
+	table.SetRows([]scoreRow{{"zed", 20}, {"amy", 80}})
+	if got := rowNames(table.Rows()); got != "amy,zed" {
+		t.Errorf("rows = %s, want the new rows sorted by score", got)
+	}
+
+	// The score column moves but keeps its title, so it stays sorted.
+	columns := scoreColumns()
+	table.SetColumns([]TableColumn[scoreRow]{columns[2], columns[1]})
+	if table.SortColumn() != 1 {
+		t.Errorf("sort column = %d, want the moved score column", table.SortColumn())
+	}
+	table.SetColumns(columns[2:])
+	if table.SortColumn() != -1 {
+		t.Errorf("sort column = %d, want none once the column is gone", table.SortColumn())
+	}
+}
+
+func TestTableFiltersByStatus(t *testing.T) {
+	table := scoreTable(10)
+	for _, want := range []struct {
+		filter StatusFilter
+		rows   string
+	}{
+		{ShowFailingRows, "carol,erin"},
+		{ShowWarningRows, "dave"},
+		{ShowPassingRows, "alice,bob"},
+		{ShowAllRows, "carol,alice,dave,bob,erin"},
+	} {
+		table.NextFilter()
+		if table.Filter() != want.filter {
+			t.Fatalf("filter = %s, want %s", table.Filter(), want.filter)
+		}
+		if got := rowNames(table.Rows()); got != want.rows {
+			t.Errorf("%s rows = %s, want %s", want.filter, got, want.rows)
+		}
+	}
+
+	table.SetFilter(ShowFailingRows)
+	view := ansi.Strip(table.View())
+	if !strings.Contains(view, "failing only (2 of 5)") {
+		t.Errorf("expected the filter in the status line, got:\n%s", view)
+	}
+	table.SetRows(nil)
+	if view := ansi.Strip(table.View()); !strings.Contains(view, "No failing rows") {
+		t.Errorf("expected an empty filter to say so, got:\n%s", view)
+	}
+}
+
+func TestTableKeepsTheHeaderWhileScrolling(t *testing.T) {
+	table := scoreTable(2)
+	table, handled := table.Update(tea.KeyPressMsg{Code: tea.KeyEnd})
+	if !handled {
+		t.Fatal("End was not handled")
+	}
+
+	lines := strings.Split(ansi.Strip(table.View()), "\n")
+	if !strings.Contains(lines[0], "NAME") || !strings.Contains(lines[0], "SCORE") {
+		t.Errorf("first line = %q, want the header", lines[0])
+	}
+	if !strings.Contains(lines[1], "bob") || !strings.Contains(lines[2], "erin") {
+		t.Errorf("expected the last two rows below the header, got:\n%s", strings.Join(lines, "\n"))
+	}
+	if !strings.Contains(lines[3], "rows 4–5 of 5") {
+		t.Errorf("status line = %q", lines[3])
+	}
+
+	table, _ = table.Update(tea.KeyPressMsg{Code: tea.KeyPgUp})
+	lines = strings.Split(ansi.Strip(table.View()), "\n")
+	if !strings.Contains(lines[0], "NAME") || !strings.Contains(lines[1], "alice") || !strings.Contains(lines[3], "rows 2–3 of 5 • ↓ 2 more") {
+		t.Errorf("expected the header above rows 2–3, got:\n%s", strings.Join(lines, "\n"))
+	}
+
+	// Scrolling past the top stops at the first row.
+	table.Scroll(-10)
+	table.Scroll(-1)
+	if lines := strings.Split(ansi.Strip(table.View()), "\n"); !strings.Contains(lines[1], "carol") {
+		t.Errorf("expected the first row at the top, got:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestTableMarksTheSortColumn(t *testing.T) {
+	table := scoreTable(10)
+	table, _ = table.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	header := strings.Split(ansi.Strip(table.View()), "\n")[0]
+	if !strings.Contains(header, "NAME↑") {
+		t.Errorf("header = %q, want NAME↑", header)
+	}
+	table, _ = table.Update(tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	header = strings.Split(ansi.Strip(table.View()), "\n")[0]
+	if !strings.Contains(header, "NAME↓") {
+		t.Errorf("header = %q, want NAME↓ after shift+tab", header)
+	}
+
+	if _, handled := table.Update(tea.KeyPressMsg{Code: tea.KeyUp}); handled {
+		t.Error("the table handled Up, which the key map leaves to the screen")
+	}
+	if !slices.Contains(table.KeyMap.Filter, "v") {
+		t.Errorf("filter keys = %v", table.KeyMap.Filter)
+	}
+}
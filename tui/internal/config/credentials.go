@@ -0,0 +1,307 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// CredentialsFileEnv overrides the location of the credential store.
+const CredentialsFileEnv = "TUI_CREDENTIALS"
+
+// PassphraseEnv supplies the credential store passphrase without a prompt,
+// for scripts and CI.
+const PassphraseEnv = "TUI_CREDENTIALS_PASSPHRASE"
+
+const (
+	credentialsVersion = 1
+	credentialsKDF     = "pbkdf2-sha256"
+	saltSize           = 16
+	keySize            = 32
+)
+
+// credentialIterations is the PBKDF2 work factor for new stores, following
+// the OWASP recommendation for SHA-256. Stores record their own, so it can be
+// raised without breaking existing ones.
+var credentialIterations = 600_000
+
+// ErrWrongPassphrase is returned when a credential store cannot be decrypted
+// with the given passphrase, or was tampered with.
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted credential store")
+
+// credentialsFile is the on-disk form of a store. The header fields are
+// authenticated with the ciphertext, so they cannot be swapped.
+type credentialsFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func (f credentialsFile) additionalData() []byte {
+	return fmt.Appendf(nil, "svelte-bench credentials v%d %s %d %x", f.Version, f.KDF, f.Iterations, f.Salt)
+}
+
+// CredentialStore keeps API keys encrypted at rest with AES-256-GCM under a
+// key derived from a passphrase. Once unlocked it holds the derived key, so
+// changes can be saved for the rest of the session without asking again.
+type CredentialStore struct {
+	path       string
+	salt       []byte
+	iterations int
+	key        []byte
+	keys       map[string]string
+}
+
+// CredentialsFilePath returns TUI_CREDENTIALS, or svelte-bench/credentials.json
+// in the user's config directory next to config.toml.
+func CredentialsFilePath() (string, error) {
+	if path := os.Getenv(CredentialsFileEnv); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "svelte-bench", "credentials.json"), nil
+}
+
+// CredentialStoreExists reports whether a store exists at path.
+func CredentialStoreExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// CreateCredentialStore returns a new, empty store for path, protected by
+// passphrase. Nothing is written until Save.
+func CreateCredentialStore(path, passphrase string) (*CredentialStore, error) {
+	if passphrase == "" {
+		return nil, errors.New("the passphrase must not be empty")
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, credentialIterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	return &CredentialStore{
+		path:       path,
+		salt:       salt,
+		iterations: credentialIterations,
+		key:        key,
+		keys:       make(map[string]string),
+	}, nil
+}
+
+// OpenCredentialStore decrypts the store at path, returning
+// ErrWrongPassphrase when passphrase does not unlock it.
+func OpenCredentialStore(path, passphrase string) (*CredentialStore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file credentialsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if file.Version != credentialsVersion || file.KDF != credentialsKDF || file.Iterations < 1 {
+		return nil, fmt.Errorf("%s: unsupported credential store (version %d, %s)", path, file.Version, file.KDF)
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, file.Salt, file.Iterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, file.Nonce, file.Ciphertext, file.additionalData())
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	keys := make(map[string]string)
+	if err := json.Unmarshal(plaintext, &keys); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &CredentialStore{
+		path:       path,
+		salt:       file.Salt,
+		iterations: file.Iterations,
+		key:        key,
+		keys:       keys,
+	}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Path returns where the store is saved.
+func (s *CredentialStore) Path() string {
+	return s.path
+}
+
+// Get returns the value stored for an API key variable, or "".
+func (s *CredentialStore) Get(name string) string {
+	return s.keys[name]
+}
+
+// Set stores value for an API key variable; an empty value removes it.
+func (s *CredentialStore) Set(name, value string) {
+	if value == "" {
+		delete(s.keys, name)
+		return
+	}
+	s.keys[name] = value
+}
+
+// Names returns the stored API key variables, sorted.
+func (s *CredentialStore) Names() []string {
+	names := make([]string, 0, len(s.keys))
+	for name := range s.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Save encrypts the keys under a fresh nonce and replaces the file
+// atomically, readable by the owner only.
+func (s *CredentialStore) Save() error {
+	plaintext, err := json.Marshal(s.keys)
+	if err != nil {
+		return err
+	}
+	aead, err := newAEAD(s.key)
+	if err != nil {
+		return err
+	}
+	file := credentialsFile{
+		Version:    credentialsVersion,
+		KDF:        credentialsKDF,
+		Iterations: s.iterations,
+		Salt:       s.salt,
+		Nonce:      make([]byte, aead.NonceSize()),
+	}
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = aead.Seal(nil, file.Nonce, plaintext, file.additionalData())
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(s.path), ".credentials-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if err := temp.Chmod(0o600); err != nil {
+		temp.Close()
+		return err
+	}
+	if _, err := temp.Write(append(data, '\n')); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), s.path)
+}
+
+// UseCredentials makes the unlocked store the source and destination of API
+// keys: its keys override those from .env, and Save writes new keys to it
+// instead of .env.
+func (c *Config) UseCredentials(store *CredentialStore) {
+	c.Credentials = store
+	if c.APIKeys == nil {
+		c.APIKeys = make(map[string]string)
+	}
+	for _, name := range store.Names() {
+		c.APIKeys[name] = store.Get(name)
+	}
+}
+
+// Save stores the API keys in the credential store when one is in use, and
+// in .env otherwise. The store ends up holding exactly the keys of APIKeys,
+// except those that were only read from .env and left unchanged; a cleared
+// key is removed from .env as well, so it does not come back next session.
+func (c *Config) Save() error {
+	if c.Credentials == nil {
+		return c.SaveToEnv()
+	}
+	var cleared []string
+	for name, value := range c.APIKeys {
+		switch {
+		case value == "":
+			c.Credentials.Set(name, "")
+			if c.envKeys[name] != "" {
+				cleared = append(cleared, name)
+			}
+		case c.Credentials.Get(name) == "" && c.envKeys[name] == value:
+			// Only in .env; migrate moves such keys on request.
+		default:
+			c.Credentials.Set(name, value)
+		}
+	}
+	for _, name := range c.Credentials.Names() {
+		if _, ok := c.APIKeys[name]; !ok {
+			c.Credentials.Set(name, "")
+		}
+	}
+	if err := c.Credentials.Save(); err != nil {
+		return err
+	}
+	if len(cleared) == 0 {
+		return nil
+	}
+	sort.Strings(cleared)
+	return removeFromEnv(cleared)
+}
+
+// MigrateToCredentials moves the API keys from .env into store and saves it,
+// then removes them from .env unless keepEnv is set. It returns the moved
+// variables.
+func MigrateToCredentials(store *CredentialStore, keepEnv bool) ([]string, error) {
+	env, err := LoadFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	var moved []string
+	for name, value := range env.APIKeys {
+		if value != "" {
+			store.Set(name, value)
+			moved = append(moved, name)
+		}
+	}
+	sort.Strings(moved)
+	if err := store.Save(); err != nil {
+		return nil, err
+	}
+	if keepEnv || len(moved) == 0 {
+		return moved, nil
+	}
+	return moved, removeFromEnv(moved)
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fastCredentials keeps key derivation cheap for the duration of a test.
+func fastCredentials(t *testing.T) {
+	t.Helper()
+	previous := credentialIterations
+	credentialIterations = 1000
+	t.Cleanup(func() { credentialIterations = previous })
+}
+
+func TestCredentialStoreRoundTrip(t *testing.T) {
+	fastCredentials(t)
+	path := filepath.Join(t.TempDir(), "svelte-bench", "credentials.json")
+
+	store, err := CreateCredentialStore(path, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Set("OPENAI_API_KEY", "sk-secret")
+	if err := store.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "sk-secret") {
+		t.Fatal("credential store holds the key in plaintext")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("mode = %o, want 600", mode)
+	}
+
+	reopened, err := OpenCredentialStore(path, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reopened.Get("OPENAI_API_KEY"); got != "sk-secret" {
+		t.Errorf("OPENAI_API_KEY = %q", got)
+	}
+
+	// The unlocked store saves again without the passphrase.
+	reopened.Set("GROQ_API_KEY", "gsk-secret")
+	if err := reopened.Save(); err != nil {
+		t.Fatal(err)
+	}
+	again, err := OpenCredentialStore(path, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(again.Names(), ","); got != "GROQ_API_KEY,OPENAI_API_KEY" {
+		t.Errorf("names = %s", got)
+	}
+}
+
+func TestOpenCredentialStoreRejectsWrongPassphrase(t *testing.T) {
+	fastCredentials(t)
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	store, err := CreateCredentialStore(path, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenCredentialStore(path, "battery staple"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("err = %v, want ErrWrongPassphrase", err)
+	}
+}
+
+func TestCredentialStoreHoldsExactlyTheSavedKeys(t *testing.T) {
+	fastCredentials(t)
+	root := t.TempDir()
+	t.Chdir(root)
+	env := "OPENAI_API_KEY=from-env\nANTHROPIC_API_KEY=env-only\nGROQ_API_KEY=cleared\n"
+	if err := os.WriteFile(filepath.Join(root, ".env"), []byte(env), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	store, err := CreateCredentialStore(filepath.Join(root, "credentials.json"), "pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Set("OPENAI_API_KEY", "from-store")
+	store.Set("MISTRAL_API_KEY", "stored")
+
+	cfg, err := LoadFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.UseCredentials(store)
+	keys := make(map[string]string)
+	for _, provider := range cfg.GetAllProvidersWithKeys() {
+		keys[provider.EnvKey] = provider.APIKey
+	}
+	if keys["OPENAI_API_KEY"] != "from-store" || keys["ANTHROPIC_API_KEY"] != "env-only" || keys["MISTRAL_API_KEY"] != "stored" {
+		t.Errorf("keys = %v", keys)
+	}
+
+	cfg.APIKeys["GROQ_API_KEY"] = ""
+	cfg.APIKeys["MISTRAL_API_KEY"] = ""
+	cfg.APIKeys["XAI_API_KEY"] = "new"
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenCredentialStore(store.Path(), "pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(reopened.Names(), ","); got != "OPENAI_API_KEY,XAI_API_KEY" {
+		t.Errorf("stored keys = %s; want cleared keys removed and .env-only keys left out", got)
+	}
+	data, err := os.ReadFile(filepath.Join(root, ".env"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "OPENAI_API_KEY=from-env\nANTHROPIC_API_KEY=env-only\n"; got != want {
+		t.Errorf(".env = %q, want %q", got, want)
+	}
+}
+
+func TestMigrateToCredentialsRemovesKeysFromEnv(t *testing.T) {
+	fastCredentials(t)
+	root := t.TempDir()
+	t.Chdir(root)
+	env := "# Keys\nOPENAI_API_KEY=sk-one\nDEBUG_MODE=true\n\nGROQ_API_KEY=gsk-two\n"
+	if err := os.WriteFile(filepath.Join(root, ".env"), []byte(env), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	store, err := CreateCredentialStore(filepath.Join(root, "credentials.json"), "pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moved, err := MigrateToCredentials(store, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(moved, ","); got != "GROQ_API_KEY,OPENAI_API_KEY" {
+		t.Errorf("moved = %s", got)
+	}
+	data, err := os.ReadFile(filepath.Join(root, ".env"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "# Keys\nDEBUG_MODE=true\n\n"; got != want {
+		t.Errorf(".env = %q, want %q", got, want)
+	}
+	reopened, err := OpenCredentialStore(store.Path(), "pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reopened.Get("OPENAI_API_KEY") != "sk-one" || reopened.Get("GROQ_API_KEY") != "gsk-two" {
+		t.Errorf("store keys = %v", reopened.Names())
+	}
+}
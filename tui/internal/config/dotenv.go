@@ -0,0 +1,355 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// envQuotes are the characters that may quote a .env value.
+const envQuotes = "\"'`"
+
+// EnvFile is a parsed .env file that keeps every byte of its source: writing
+// it back reproduces the file exactly, except for the assignments changed
+// through Set and Delete.
+//
+// It understands the dotenv syntax in common use:
+//
+//	# comment lines and blank lines
+//	KEY=value                 unquoted, surrounding blanks trimmed
+//	export KEY=value          shell-style export prefix
+//	KEY = value # comment     a # after a blank starts an inline comment
+//	KEY="a\nb" # comment      \n, \r, \t, \" and \\ escapes in double quotes
+//	KEY='literal $text'       single quotes and backticks are literal
+//	KEY="multi
+//	line"                     quoted values may span lines
+//
+// Variables such as ${HOME} are not expanded. When a key is assigned more
+// than once the last assignment wins. A line that cannot be parsed, such as
+// an unclosed quote, is kept as it is but assigns nothing; Warnings says why.
+type EnvFile struct {
+	// Warnings describe the lines that could not be parsed.
+	Warnings []string
+	entries  []envEntry
+	// newline is the line break used for appended lines: the file's own.
+	newline string
+}
+
+// envEntry is one line of a .env file, or several lines for a multi-line
+// quoted value.
+type envEntry struct {
+	text string
+	// key is empty for comments, blank lines and lines that do not assign.
+	key   string
+	value string
+	// valueStart and valueEnd delimit the value in text as written, quotes
+	// included.
+	valueStart, valueEnd int
+	// quote is the quote character around the value, or 0.
+	quote byte
+}
+
+// ParseEnvFile parses the contents of a .env file.
+func ParseEnvFile(data string) *EnvFile {
+	file := &EnvFile{newline: "\n"}
+	if i := strings.IndexByte(data, '\n'); i > 0 && data[i-1] == '\r' {
+		file.newline = "\r\n"
+	}
+
+	lines := strings.SplitAfter(data, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for i := 0; i < len(lines); {
+		entry, consumed, err := parseEnvEntry(lines[i:], i+1)
+		if err != nil {
+			file.Warnings = append(file.Warnings, err.Error())
+			entry, consumed = envEntry{text: lines[i]}, 1
+		}
+		file.entries = append(file.entries, entry)
+		i += consumed
+	}
+	return file
+}
+
+// ReadEnvFile reads and parses the .env file at path, prefixing its warnings
+// with the path. A missing file gives an empty EnvFile and an error wrapping
+// os.ErrNotExist, so callers can create it.
+func ReadEnvFile(path string) (*EnvFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return &EnvFile{newline: "\n"}, err
+	}
+	file := ParseEnvFile(string(data))
+	for i, warning := range file.Warnings {
+		file.Warnings[i] = path + ": " + warning
+	}
+	return file, nil
+}
+
+// parseEnvEntry parses the entry starting at lines[0], which is line number
+// lineNo, and returns how many lines it spans.
+func parseEnvEntry(lines []string, lineNo int) (envEntry, int, error) {
+	line := lines[0]
+	entry := envEntry{text: line}
+
+	pos := skipEnvBlanks(line, 0)
+	if rest := line[pos:]; strings.HasPrefix(rest, "export") && len(rest) > 6 && isEnvBlank(rest[6]) {
+		pos = skipEnvBlanks(line, pos+6)
+	}
+	start := pos
+	for pos < len(line) && isEnvKeyChar(line[pos]) {
+		pos++
+	}
+	key := line[start:pos]
+	pos = skipEnvBlanks(line, pos)
+	if key == "" || pos >= len(line) || line[pos] != '=' {
+		// A comment, a blank line or something that is not an assignment.
+		return entry, 1, nil
+	}
+	pos = skipEnvBlanks(line, pos+1)
+	entry.key = key
+	entry.valueStart = pos
+
+	if pos < len(line) && strings.IndexByte(envQuotes, line[pos]) >= 0 {
+		return parseQuotedEnvValue(entry, lines, lineNo)
+	}
+
+	end := len(strings.TrimRight(line, "\r\n"))
+	for i := pos; i < end; i++ {
+		if line[i] == '#' && isEnvBlank(line[i-1]) {
+			end = i
+			break
+		}
+	}
+	entry.value = strings.TrimRight(line[pos:end], " \t")
+	entry.valueEnd = pos + len(entry.value)
+	return entry, 1, nil
+}
+
+// parseQuotedEnvValue reads the quoted value starting at entry.valueStart,
+// taking further lines until the closing quote.
+func parseQuotedEnvValue(entry envEntry, lines []string, lineNo int) (envEntry, int, error) {
+	quote := entry.text[entry.valueStart]
+	used := 1
+	i := entry.valueStart + 1
+	for {
+		if i >= len(entry.text) {
+			if used == len(lines) {
+				return envEntry{}, 0, fmt.Errorf("line %d: %s has no closing %c", lineNo, entry.key, quote)
+			}
+			entry.text += lines[used]
+			used++
+			continue
+		}
+		if entry.text[i] == '\\' && quote == '"' && i+1 < len(entry.text) {
+			i += 2
+			continue
+		}
+		if entry.text[i] == quote {
+			break
+		}
+		i++
+	}
+
+	entry.quote = quote
+	entry.valueEnd = i + 1
+	raw := strings.ReplaceAll(entry.text[entry.valueStart+1:i], "\r\n", "\n")
+	if quote == '"' {
+		raw = unescapeEnvValue(raw)
+	}
+	entry.value = raw
+
+	rest := strings.TrimSpace(entry.text[entry.valueEnd:])
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return envEntry{}, 0, fmt.Errorf("line %d: unexpected %q after the value of %s", lineNo+used-1, rest, entry.key)
+	}
+	return entry, used, nil
+}
+
+func unescapeEnvValue(raw string) string {
+	var value strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' || i+1 == len(raw) {
+			value.WriteByte(raw[i])
+			continue
+		}
+		i++
+		switch raw[i] {
+		case 'n':
+			value.WriteByte('\n')
+		case 'r':
+			value.WriteByte('\r')
+		case 't':
+			value.WriteByte('\t')
+		case '"', '\\':
+			value.WriteByte(raw[i])
+		default:
+			value.WriteByte('\\')
+			value.WriteByte(raw[i])
+		}
+	}
+	return value.String()
+}
+
+// formatEnvValue writes value for a .env file, keeping quote when the value
+// can be written with it and adding double quotes when it has to be quoted.
+func formatEnvValue(value string, quote byte) string {
+	needsQuotes := value != strings.TrimSpace(value) ||
+		strings.ContainsAny(value, "#\n\r") ||
+		(value != "" && strings.IndexByte(envQuotes, value[0]) >= 0)
+	switch {
+	case (quote == '\'' || quote == '`') && !strings.ContainsAny(value, string(quote)+"\n\r"):
+		return string(quote) + value + string(quote)
+	case quote == 0 && !needsQuotes:
+		return value
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`).Replace(value)
+	return `"` + escaped + `"`
+}
+
+func skipEnvBlanks(line string, pos int) int {
+	for pos < len(line) && isEnvBlank(line[pos]) {
+		pos++
+	}
+	return pos
+}
+
+func isEnvBlank(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+func isEnvKeyChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// lookup returns the index of the assignment of key that takes effect, or -1.
+func (f *EnvFile) lookup(key string) int {
+	for i := len(f.entries) - 1; i >= 0; i-- {
+		if f.entries[i].key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// Get returns the value assigned to key.
+func (f *EnvFile) Get(key string) (string, bool) {
+	if i := f.lookup(key); i >= 0 {
+		return f.entries[i].value, true
+	}
+	return "", false
+}
+
+// Keys returns the assigned keys in file order.
+func (f *EnvFile) Keys() []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, entry := range f.entries {
+		if entry.key != "" && !seen[entry.key] {
+			seen[entry.key] = true
+			keys = append(keys, entry.key)
+		}
+	}
+	return keys
+}
+
+// Set assigns value to key. An existing assignment is rewritten in place,
+// keeping its export prefix, spacing, quote style and inline comment; a new
+// key is appended at the end of the file.
+func (f *EnvFile) Set(key, value string) {
+	i := f.lookup(key)
+	if i < 0 {
+		if n := len(f.entries); n > 0 && !strings.HasSuffix(f.entries[n-1].text, "\n") {
+			f.entries[n-1].text += f.newline
+		}
+		f.entries = append(f.entries, mustParseEnvEntry(key+"="+formatEnvValue(value, 0)+f.newline))
+		return
+	}
+
+	entry := f.entries[i]
+	if entry.value == value {
+		return
+	}
+	rest := entry.text[entry.valueEnd:]
+	if strings.HasPrefix(rest, "#") {
+		// An empty value followed by an inline comment, as in "KEY= # note":
+		// without a blank the comment would become part of the new value.
+		rest = " " + rest
+	}
+	text := entry.text[:entry.valueStart] + formatEnvValue(value, entry.quote) + rest
+	f.entries[i] = mustParseEnvEntry(text)
+}
+
+// Delete removes every assignment of key.
+func (f *EnvFile) Delete(key string) {
+	kept := f.entries[:0]
+	for _, entry := range f.entries {
+		if entry.key != key {
+			kept = append(kept, entry)
+		}
+	}
+	f.entries = kept
+}
+
+// mustParseEnvEntry parses an entry written by Set, which always parses.
+func mustParseEnvEntry(text string) envEntry {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	entry, _, err := parseEnvEntry(lines, 1)
+	if err != nil {
+		panic(err)
+	}
+	entry.text = text
+	return entry
+}
+
+// String returns the file's contents.
+func (f *EnvFile) String() string {
+	var text strings.Builder
+	for _, entry := range f.entries {
+		text.WriteString(entry.text)
+	}
+	return text.String()
+}
+
+// WriteFile replaces the file at path atomically, keeping the permissions of
+// an existing file and creating a new one readable by the owner only.
+func (f *EnvFile) WriteFile(path string) error {
+	mode := os.FileMode(0o600)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), ".env-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if err := temp.Chmod(mode); err != nil {
+		temp.Close()
+		return err
+	}
+	if _, err := temp.WriteString(f.String()); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// readEnvFileOrNew reads the .env file at path, or starts one with header
+// when it does not exist yet.
+func readEnvFileOrNew(path, header string) (*EnvFile, error) {
+	file, err := ReadEnvFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ParseEnvFile(header), nil
+	}
+	return file, err
+}
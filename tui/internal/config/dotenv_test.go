@@ -0,0 +1,252 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleEnv = `# Native SDK Providers (with extended features)
+OPENAI_API_KEY=sk-plain
+export ANTHROPIC_API_KEY="sk-ant-quoted" # from the console
+GEMINI_API_KEY = 'literal $HOME'
+
+# Ollama configuration (optional)
+# OLLAMA_HOST=http://127.0.0.1:11434
+OPENROUTER_SITE_URL=https://github.com/khromov/svelte-bench  # Optional
+DEBUG_TEST=counter#2
+PRIVATE_KEY="-----BEGIN KEY-----
+abc\"def
+-----END KEY-----"
+ESCAPED="tab\there\nnewline \\ backslash"
+EMPTY=
+EMPTY_COMMENT= # nothing here
+not an assignment
+export=value-of-export
+	INDENTED.KEY-1=  spaced value  `
+
+func TestParseEnvFileValues(t *testing.T) {
+	file := ParseEnvFile(sampleEnv)
+	want := map[string]string{
+		"OPENAI_API_KEY":      "sk-plain",
+		"ANTHROPIC_API_KEY":   "sk-ant-quoted",
+		"GEMINI_API_KEY":      "literal $HOME",
+		"OPENROUTER_SITE_URL": "https://github.com/khromov/svelte-bench",
+		"DEBUG_TEST":          "counter#2",
+		"PRIVATE_KEY":         "-----BEGIN KEY-----\nabc\"def\n-----END KEY-----",
+		"ESCAPED":             "tab\there\nnewline \\ backslash",
+		"EMPTY":               "",
+		"EMPTY_COMMENT":       "",
+		"export":              "value-of-export",
+		"INDENTED.KEY-1":      "spaced value",
+	}
+	for key, value := range want {
+		got, ok := file.Get(key)
+		if !ok || got != value {
+			t.Errorf("%s = %q (set %v), want %q", key, got, ok, value)
+		}
+	}
+	if got := len(file.Keys()); got != len(want) {
+		t.Errorf("keys = %v", file.Keys())
+	}
+	if _, ok := file.Get("OLLAMA_HOST"); ok {
+		t.Error("a commented-out assignment was read")
+	}
+}
+
+func TestParseEnvFileRoundTrips(t *testing.T) {
+	for _, data := range []string{
+		sampleEnv,
+		sampleEnv + "\n",
+		strings.ReplaceAll(sampleEnv, "\n", "\r\n"),
+		"",
+		"\n\n# only comments\n",
+	} {
+		file := ParseEnvFile(data)
+		if got := file.String(); got != data {
+			t.Errorf("round trip changed the file:\n got %q\nwant %q", got, data)
+		}
+	}
+}
+
+func TestEnvFileSetRewritesOnlyTheChangedValue(t *testing.T) {
+	file := ParseEnvFile(sampleEnv)
+	file.Set("OPENAI_API_KEY", "sk-plain") // unchanged
+	file.Set("ANTHROPIC_API_KEY", "sk-ant-new")
+	file.Set("GEMINI_API_KEY", "it's")
+	file.Set("PRIVATE_KEY", "short")
+	file.Set("OPENROUTER_SITE_URL", "has #hash")
+
+	want := strings.NewReplacer(
+		`export ANTHROPIC_API_KEY="sk-ant-quoted" # from the console`, `export ANTHROPIC_API_KEY="sk-ant-new" # from the console`,
+		`GEMINI_API_KEY = 'literal $HOME'`, `GEMINI_API_KEY = "it's"`,
+		"PRIVATE_KEY=\"-----BEGIN KEY-----\nabc\\\"def\n-----END KEY-----\"", `PRIVATE_KEY="short"`,
+		`OPENROUTER_SITE_URL=https://github.com/khromov/svelte-bench  # Optional`, `OPENROUTER_SITE_URL="has #hash"  # Optional`,
+	).Replace(sampleEnv)
+	if got := file.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	reparsed := ParseEnvFile(file.String())
+	for _, key := range []string{"ANTHROPIC_API_KEY", "GEMINI_API_KEY", "PRIVATE_KEY", "OPENROUTER_SITE_URL"} {
+		before, _ := file.Get(key)
+		if after, _ := reparsed.Get(key); after != before {
+			t.Errorf("%s reparsed as %q, want %q", key, after, before)
+		}
+	}
+}
+
+func TestEnvFileSetAppendsNewKeys(t *testing.T) {
+	for _, test := range []struct {
+		name, data, want string
+	}{
+		{"empty", "", "GROQ_API_KEY=gsk\n"},
+		{"trailing newline", "A=1\n", "A=1\nGROQ_API_KEY=gsk\n"},
+		{"no trailing newline", "A=1", "A=1\nGROQ_API_KEY=gsk\n"},
+		{"crlf", "A=1\r\n", "A=1\r\nGROQ_API_KEY=gsk\r\n"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			file := ParseEnvFile(test.data)
+			file.Set("GROQ_API_KEY", "gsk")
+			if got := file.String(); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnvFileSetFillsEmptyValueBeforeComment(t *testing.T) {
+	for data, want := range map[string]string{
+		"OPENAI_API_KEY= # your key here\n":  "OPENAI_API_KEY= sk-123 # your key here\n",
+		"OPENAI_API_KEY=\t# your key here\n": "OPENAI_API_KEY=\tsk-123 # your key here\n",
+		"OPENAI_API_KEY= \n":                 "OPENAI_API_KEY= sk-123\n",
+	} {
+		file := ParseEnvFile(data)
+		file.Set("OPENAI_API_KEY", "sk-123")
+		if got := file.String(); got != want {
+			t.Errorf("%q: got %q, want %q", data, got, want)
+		}
+		if got, _ := ParseEnvFile(file.String()).Get("OPENAI_API_KEY"); got != "sk-123" {
+			t.Errorf("%q: reparsed as %q", data, got)
+		}
+	}
+}
+
+func TestEnvFileLastAssignmentWins(t *testing.T) {
+	file := ParseEnvFile("KEY=first\nKEY=second\n")
+	if got, _ := file.Get("KEY"); got != "second" {
+		t.Errorf("KEY = %q", got)
+	}
+	file.Set("KEY", "third")
+	if got := file.String(); got != "KEY=first\nKEY=third\n" {
+		t.Errorf("got %q", got)
+	}
+	file.Delete("KEY")
+	if got := file.String(); got != "" {
+		t.Errorf("after Delete got %q", got)
+	}
+}
+
+func TestParseEnvFileKeepsBrokenLines(t *testing.T) {
+	for data, want := range map[string]string{
+		"A=1\nKEY=\"unterminated\nB=2\n":   "line 2: KEY has no closing \"",
+		"A=1\nKEY='value' trailing\nB=2\n": `line 2: unexpected "trailing"`,
+	} {
+		file := ParseEnvFile(data)
+		if len(file.Warnings) != 1 || !strings.Contains(file.Warnings[0], want) {
+			t.Errorf("%q: warnings = %q, want %q", data, file.Warnings, want)
+		}
+		if _, ok := file.Get("KEY"); ok {
+			t.Errorf("%q: the broken line assigned KEY", data)
+		}
+		a, _ := file.Get("A")
+		b, _ := file.Get("B")
+		if a != "1" || b != "2" {
+			t.Errorf("%q: A=%q B=%q, want the lines around the broken one", data, a, b)
+		}
+		file.Set("A", "changed")
+		if got, want := file.String(), strings.Replace(data, "A=1", "A=changed", 1); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestLoadFromEnvSkipsBrokenLines(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+	env := "OPENAI_API_KEY=sk-one\nANTHROPIC_API_KEY=\"sk-ant\n# trailing comment\n"
+	if err := os.WriteFile(filepath.Join(root, ".env"), []byte(env), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.APIKeys["OPENAI_API_KEY"] != "sk-one" {
+		t.Errorf("keys = %v", cfg.APIKeys)
+	}
+	if _, ok := cfg.APIKeys["ANTHROPIC_API_KEY"]; ok {
+		t.Error("the unterminated value was read")
+	}
+	if len(cfg.EnvWarnings) != 1 || !strings.Contains(cfg.EnvWarnings[0], "line 2: ANTHROPIC_API_KEY has no closing") {
+		t.Errorf("warnings = %q", cfg.EnvWarnings)
+	}
+}
+
+func TestSaveToEnvPreservesTheFile(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+	envPath := filepath.Join(root, ".env")
+	if err := os.WriteFile(envPath, []byte(sampleEnv), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.APIKeys["ANTHROPIC_API_KEY"]; got != "sk-ant-quoted" {
+		t.Errorf("ANTHROPIC_API_KEY = %q", got)
+	}
+	cfg.APIKeys["GROQ_API_KEY"] = "gsk-new"
+	if err := cfg.SaveToEnv(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(envPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sampleEnv + "\nGROQ_API_KEY=gsk-new\n"; string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+	info, err := os.Stat(envPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o644 {
+		t.Errorf("mode = %o, want the file's own 644", mode)
+	}
+	if leftovers, _ := filepath.Glob(filepath.Join(root, ".env-*")); len(leftovers) != 0 {
+		t.Errorf("temporary files left behind: %v", leftovers)
+	}
+}
+
+func TestSaveToEnvCreatesTheFile(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+
+	cfg := &Config{APIKeys: map[string]string{"OPENAI_API_KEY": "sk", "GROQ_API_KEY": "gsk"}}
+	if err := cfg.SaveToEnv(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(root, ".env"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := envHeader + "GROQ_API_KEY=gsk\nOPENAI_API_KEY=sk\n"; string(data) != want {
+		t.Errorf("got %q, want %q", data, want)
+	}
+}
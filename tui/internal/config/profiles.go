@@ -0,0 +1,294 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ConfigFileEnv overrides the location of the TUI config file.
+const ConfigFileEnv = "TUI_CONFIG"
+
+// ExecutionModes are the execution modes a profile may select.
+var ExecutionModes = []string{"parallel", "sequential", "madmax"}
+
+// Profile is a named benchmark configuration from the config file. Empty
+// fields are chosen in the TUI as usual.
+type Profile struct {
+	Name string
+	// Provider is the runner's provider ID, such as "openrouter".
+	Provider string
+	Models   []string
+	// Mode is one of ExecutionModes, or empty to ask.
+	Mode    string
+	Samples int
+	// Tests limits the run to these test categories; empty runs every test.
+	Tests []string
+	// Context is a context file passed to the runner, relative to the
+	// project root.
+	Context string
+	// Retry overrides the runner's retry options; zero fields keep the
+	// runner's defaults.
+	Retry RetrySettings
+}
+
+// RetrySettings are the retry options of src/utils/retry-wrapper.ts, the
+// RETRY_* variables of the runner's environment.
+type RetrySettings struct {
+	MaxAttempts    int     `json:"maxAttempts"`
+	InitialDelayMs int     `json:"initialDelayMs"`
+	MaxDelayMs     int     `json:"maxDelayMs"`
+	BackoffFactor  float64 `json:"backoffFactor"`
+}
+
+// Settings is the TUI config file: named profiles and which one the welcome
+// screen focuses first.
+type Settings struct {
+	Path           string
+	DefaultProfile string
+	// Profiles are sorted by name.
+	Profiles []Profile
+}
+
+// Profile returns the profile called name.
+func (s Settings) Profile(name string) (Profile, bool) {
+	for _, profile := range s.Profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return Profile{}, false
+}
+
+// CheckTests reports the first test a profile names that is not one of
+// available, the test categories under src/tests, so a typo is caught when
+// the profile loads rather than when the run fails.
+func (s Settings) CheckTests(available []string) error {
+	for _, profile := range s.Profiles {
+		for _, test := range profile.Tests {
+			if !slices.Contains(available, test) {
+				return fmt.Errorf("profile %s: unknown test %q", profile.Name, test)
+			}
+		}
+	}
+	return nil
+}
+
+// ConfigFilePath returns TUI_CONFIG, or svelte-bench/config.toml in the user's
+// config directory: $XDG_CONFIG_HOME or ~/.config on Linux, ~/Library/Application
+// Support on macOS and %AppData% on Windows.
+func ConfigFilePath() (string, error) {
+	if path := os.Getenv(ConfigFileEnv); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "svelte-bench", "config.toml"), nil
+}
+
+// LoadSettings reads the TUI config file. A missing file gives empty
+// settings.
+func LoadSettings() (Settings, error) {
+	path, err := ConfigFilePath()
+	if err != nil {
+		return Settings{}, err
+	}
+	return LoadSettingsFile(path)
+}
+
+// LoadSettingsFile reads the config file at path. A missing file gives empty
+// settings.
+func LoadSettingsFile(path string) (Settings, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Settings{Path: path}, nil
+	}
+	if err != nil {
+		return Settings{}, err
+	}
+	settings, err := ParseSettings(string(data))
+	if err != nil {
+		return Settings{}, fmt.Errorf("%s: %w", path, err)
+	}
+	settings.Path = path
+	return settings, nil
+}
+
+// ParseSettings parses a config file such as:
+//
+//	default_profile = "nightly"
+//
+//	[profiles.nightly]
+//	provider = "openrouter"
+//	models = ["openai/gpt-5", "anthropic/claude-sonnet-4"]
+//	mode = "parallel"
+//	samples = 10
+//	tests = ["counter", "effect"]
+//	context = "context/svelte.dev/llms-small.txt"
+//
+//	[profiles.nightly.retry]
+//	max_attempts = 8
+//
+// Unknown keys and tables are errors, so a typo does not silently fall back
+// to a default.
+func ParseSettings(data string) (Settings, error) {
+	tables, err := parseTOML(data)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	var settings Settings
+	for key, value := range tables[""] {
+		if key != "default_profile" {
+			return Settings{}, fmt.Errorf("line %d: unknown setting %s", value.line, key)
+		}
+		if settings.DefaultProfile, err = tomlString(key, value); err != nil {
+			return Settings{}, err
+		}
+	}
+
+	profiles := make(map[string]*Profile)
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	// Parents sort before their [.retry] tables.
+	sort.Strings(names)
+	for _, path := range names {
+		if path == "" {
+			continue
+		}
+		name, ok := strings.CutPrefix(path, "profiles.")
+		switch {
+		case path == "profiles" && len(tables[path]) == 0:
+			continue
+		case !ok:
+			return Settings{}, fmt.Errorf("unknown table [%s]; profiles go in [profiles.<name>]", path)
+		}
+
+		if base, ok := strings.CutSuffix(name, ".retry"); ok {
+			if profiles[base] == nil {
+				return Settings{}, fmt.Errorf("[%s] has no [profiles.%s] table", path, base)
+			}
+			if err := decodeRetry(&profiles[base].Retry, tables[path]); err != nil {
+				return Settings{}, fmt.Errorf("profile %s: %w", base, err)
+			}
+			continue
+		}
+		profile := &Profile{Name: name}
+		if err := decodeProfile(profile, tables[path]); err != nil {
+			return Settings{}, fmt.Errorf("profile %s: %w", name, err)
+		}
+		profiles[name] = profile
+	}
+
+	for _, profile := range profiles {
+		settings.Profiles = append(settings.Profiles, *profile)
+	}
+	sort.Slice(settings.Profiles, func(i, j int) bool {
+		return settings.Profiles[i].Name < settings.Profiles[j].Name
+	})
+	if settings.DefaultProfile != "" && profiles[settings.DefaultProfile] == nil {
+		return Settings{}, fmt.Errorf("default_profile %q is not defined", settings.DefaultProfile)
+	}
+	return settings, nil
+}
+
+func decodeProfile(profile *Profile, table tomlTable) error {
+	for key, value := range table {
+		var err error
+		switch key {
+		case "provider":
+			profile.Provider, err = tomlString(key, value)
+		case "models":
+			profile.Models, err = tomlStrings(key, value)
+		case "mode":
+			profile.Mode, err = tomlString(key, value)
+			if err == nil && !slices.Contains(ExecutionModes, profile.Mode) {
+				err = fmt.Errorf("line %d: mode must be one of %s", value.line, strings.Join(ExecutionModes, ", "))
+			}
+		case "samples":
+			profile.Samples, err = tomlInt(key, value)
+			if err == nil && profile.Samples < 1 {
+				err = fmt.Errorf("line %d: samples must be at least 1", value.line)
+			}
+		case "tests":
+			profile.Tests, err = tomlStrings(key, value)
+		case "context":
+			profile.Context, err = tomlString(key, value)
+		default:
+			err = fmt.Errorf("line %d: unknown setting %s", value.line, key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeRetry(retry *RetrySettings, table tomlTable) error {
+	for key, value := range table {
+		var err error
+		switch key {
+		case "max_attempts":
+			retry.MaxAttempts, err = tomlInt(key, value)
+		case "initial_delay_ms":
+			retry.InitialDelayMs, err = tomlInt(key, value)
+		case "max_delay_ms":
+			retry.MaxDelayMs, err = tomlInt(key, value)
+		case "backoff_factor":
+			switch number := value.value.(type) {
+			case float64:
+				retry.BackoffFactor = number
+			case int64:
+				retry.BackoffFactor = float64(number)
+			default:
+				err = fmt.Errorf("line %d: %s must be a number", value.line, key)
+			}
+		default:
+			err = fmt.Errorf("line %d: unknown retry setting %s", value.line, key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tomlString(key string, value tomlValue) (string, error) {
+	text, ok := value.value.(string)
+	if !ok {
+		return "", fmt.Errorf("line %d: %s must be a string", value.line, key)
+	}
+	return text, nil
+}
+
+func tomlInt(key string, value tomlValue) (int, error) {
+	number, ok := value.value.(int64)
+	if !ok || number < 0 {
+		return 0, fmt.Errorf("line %d: %s must be a non-negative integer", value.line, key)
+	}
+	return int(number), nil
+}
+
+func tomlStrings(key string, value tomlValue) ([]string, error) {
+	items, ok := value.value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("line %d: %s must be an array of strings", value.line, key)
+	}
+	texts := make([]string, 0, len(items))
+	for _, item := range items {
+		text, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("line %d: %s must be an array of strings", value.line, key)
+		}
+		texts = append(texts, text)
+	}
+	return texts, nil
+}
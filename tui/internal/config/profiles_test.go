@@ -0,0 +1,128 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const nightlyConfig = `# Profiles for scheduled runs
+default_profile = "nightly"
+
+[profiles.nightly]
+provider = "openrouter"
+models = [
+  "openai/gpt-5",      # flagship
+  "anthropic/claude-sonnet-4",
+]
+mode = "parallel"
+samples = 10
+tests = ['counter', "effect"]
+context = "context/svelte.dev/llms-small.txt"
+
+[profiles.nightly.retry]
+max_attempts = 8
+backoff_factor = 1.5
+
+[profiles."quick check"]
+provider = "openai"
+samples = 1
+`
+
+func TestParseSettingsReadsProfiles(t *testing.T) {
+	settings, err := ParseSettings(nightlyConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.DefaultProfile != "nightly" || len(settings.Profiles) != 2 {
+		t.Fatalf("unexpected settings %+v", settings)
+	}
+
+	nightly, ok := settings.Profile("nightly")
+	if !ok {
+		t.Fatal("expected the nightly profile")
+	}
+	if nightly.Provider != "openrouter" || nightly.Mode != "parallel" || nightly.Samples != 10 ||
+		nightly.Context != "context/svelte.dev/llms-small.txt" {
+		t.Fatalf("unexpected profile %+v", nightly)
+	}
+	if !slices.Equal(nightly.Models, []string{"openai/gpt-5", "anthropic/claude-sonnet-4"}) ||
+		!slices.Equal(nightly.Tests, []string{"counter", "effect"}) {
+		t.Fatalf("unexpected lists %v %v", nightly.Models, nightly.Tests)
+	}
+	if nightly.Retry != (RetrySettings{MaxAttempts: 8, BackoffFactor: 1.5}) {
+		t.Fatalf("unexpected retry settings %+v", nightly.Retry)
+	}
+	if quick, ok := settings.Profile("quick check"); !ok || quick.Samples != 1 {
+		t.Fatalf("expected the quoted profile name, got %+v", settings.Profiles)
+	}
+}
+
+func TestParseSettingsRejectsMistakes(t *testing.T) {
+	for name, input := range map[string]string{
+		"unknown key":      "[profiles.a]\nsampels = 3\n",
+		"unknown table":    "[profile.a]\nsamples = 3\n",
+		"bad mode":         "[profiles.a]\nmode = \"fast\"\n",
+		"zero samples":     "[profiles.a]\nsamples = 0\n",
+		"wrong type":       "[profiles.a]\nmodels = \"gpt-5\"\n",
+		"unquoted string":  "[profiles.a]\nprovider = openai\n",
+		"missing default":  "default_profile = \"b\"\n[profiles.a]\n",
+		"orphan retry":     "[profiles.a.retry]\nmax_attempts = 2\n",
+		"duplicate key":    "[profiles.a]\nsamples = 1\nsamples = 2\n",
+		"unterminated":     "[profiles.a]\nprovider = \"openai\n",
+		"trailing garbage": "[profiles.a]\nsamples = 1 2\n",
+	} {
+		if _, err := ParseSettings(input); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	_, err := ParseSettings("[profiles.a]\nprovider = \"openai\"\nsampels = 3\n")
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected the error to name the line, got %v", err)
+	}
+}
+
+func TestLoadSettingsFollowsConfigFileEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	t.Setenv(ConfigFileEnv, path)
+
+	settings, err := LoadSettings()
+	if err != nil || len(settings.Profiles) != 0 || settings.Path != path {
+		t.Fatalf("expected empty settings for a missing file, got %+v, %v", settings, err)
+	}
+
+	if err := os.WriteFile(path, []byte(nightlyConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	settings, err = LoadSettings()
+	if err != nil || len(settings.Profiles) != 2 {
+		t.Fatalf("expected the profiles of %s, got %+v, %v", path, settings, err)
+	}
+
+	if runtime.GOOS != "linux" {
+		return
+	}
+	t.Setenv(ConfigFileEnv, "")
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+	if got, err := ConfigFilePath(); err != nil || got != filepath.Join("/tmp/xdg", "svelte-bench", "config.toml") {
+		t.Fatalf("expected the XDG config directory, got %q, %v", got, err)
+	}
+}
+
+func TestCheckTestsNamesTheUnknownTest(t *testing.T) {
+	settings, err := ParseSettings(nightlyConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := settings.CheckTests([]string{"counter", "derived", "effect"}); err != nil {
+		t.Fatalf("expected the nightly tests to exist, got %v", err)
+	}
+	err = settings.CheckTests([]string{"counter", "derived"})
+	if err == nil || err.Error() != `profile nightly: unknown test "effect"` {
+		t.Fatalf("expected the missing test named with its profile, got %v", err)
+	}
+}
@@ -1,21 +1,28 @@
 package config
 
 import (
-	"bufio"
-	"fmt"
+	"errors"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 )
 
-// Config holds the application configuration
+// Config holds the credentials from the project .env, and from the encrypted
+// credential store once unlocked. Run settings live in the TUI config file;
+// see Settings.
 type Config struct {
-	APIKeys      map[string]string
-	LastProvider string
-	LastModel    string
-	LastExecMode string
-	SaveToFile   bool
+	APIKeys    map[string]string
+	SaveToFile bool
+	// Credentials is the unlocked credential store, or nil to keep keys in
+	// .env.
+	Credentials *CredentialStore
+	// EnvWarnings describe .env lines that could not be parsed and were
+	// skipped.
+	EnvWarnings []string
+	// envKeys are the API keys as read from .env, so Save can tell them from
+	// keys the user entered.
+	envKeys map[string]string
 }
 
 // Provider represents an LLM provider
@@ -49,110 +56,79 @@ func AllProviders() []Provider {
 	return providers
 }
 
+// envHeader starts a .env file created by the TUI.
+const envHeader = "# SvelteBench Configuration\n# Generated by TUI\n\n"
+
+// envFilePath returns the project's .env.
+func envFilePath() string {
+	return filepath.Join(getProjectRoot(), ".env")
+}
+
 // LoadFromEnv loads configuration from .env file
 func LoadFromEnv() (*Config, error) {
 	config := &Config{
 		APIKeys: make(map[string]string),
+		envKeys: make(map[string]string),
 	}
 
-	envPath := filepath.Join(getProjectRoot(), ".env")
-
-	file, err := os.Open(envPath)
-	if err != nil {
+	file, err := ReadEnvFile(envFilePath())
+	if errors.Is(err, os.ErrNotExist) {
 		// .env doesn't exist, return empty config
 		return config, nil
 	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-
-		// Skip comments and empty lines
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		// Parse KEY=value
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) != 2 {
-			continue
-		}
-
-		key := strings.TrimSpace(parts[0])
-		value := strings.TrimSpace(parts[1])
+	if err != nil {
+		return config, err
+	}
+	config.EnvWarnings = file.Warnings
 
-		// Store API keys
+	// Store API keys
+	for _, key := range file.Keys() {
 		if strings.HasSuffix(key, "_API_KEY") {
-			config.APIKeys[key] = value
-		}
-
-		// Load last used settings
-		switch key {
-		case "LAST_PROVIDER":
-			config.LastProvider = value
-		case "LAST_MODEL":
-			config.LastModel = value
-		case "LAST_EXECUTION_MODE":
-			config.LastExecMode = value
+			config.APIKeys[key], _ = file.Get(key)
+			config.envKeys[key] = config.APIKeys[key]
 		}
 	}
-
-	return config, scanner.Err()
+	return config, nil
 }
 
-// SaveToEnv saves configuration to .env file
+// SaveToEnv saves the API keys to .env, removing the keys that were cleared.
+// Only the assignments of changed keys are rewritten; comments, ordering and
+// every other setting stay as they are.
 func (c *Config) SaveToEnv() error {
-	envPath := filepath.Join(getProjectRoot(), ".env")
-
-	// Read existing file
-	existing := make(map[string]string)
-	file, err := os.Open(envPath)
-	if err == nil {
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			line := strings.TrimSpace(scanner.Text())
-			if line != "" && !strings.HasPrefix(line, "#") {
-				parts := strings.SplitN(line, "=", 2)
-				if len(parts) == 2 {
-					existing[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
-				}
-			}
-		}
-		file.Close()
+	envPath := envFilePath()
+	file, err := readEnvFileOrNew(envPath, envHeader)
+	if err != nil {
+		return err
 	}
 
-	// Update with new values
-	for key, value := range c.APIKeys {
-		if value != "" {
-			existing[key] = value
+	// New keys are appended in a stable order.
+	keys := make([]string, 0, len(c.APIKeys))
+	for key := range c.APIKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if value := c.APIKeys[key]; value != "" {
+			file.Set(key, value)
+		} else {
+			file.Delete(key)
 		}
 	}
+	return file.WriteFile(envPath)
+}
 
-	// The TUI owns the run selection for the current session. Keep .env focused
-	// on credentials and preserve legacy LAST_* values when they already exist,
-	// but do not create or update them for new TUI runs.
-
-	// Write back
-	outFile, err := os.Create(envPath)
+// removeFromEnv deletes the assignments of names from .env, leaving every
+// other line as it is.
+func removeFromEnv(names []string) error {
+	envPath := envFilePath()
+	file, err := ReadEnvFile(envPath)
 	if err != nil {
 		return err
 	}
-	defer outFile.Close()
-
-	writer := bufio.NewWriter(outFile)
-
-	// Write header comment
-	fmt.Fprintln(writer, "# SvelteBench Configuration")
-	fmt.Fprintln(writer, "# Generated by TUI")
-	fmt.Fprintln(writer, "")
-
-	// Write keys
-	for key, value := range existing {
-		fmt.Fprintf(writer, "%s=%s\n", key, value)
+	for _, name := range names {
+		file.Delete(name)
 	}
-
-	return writer.Flush()
+	return file.WriteFile(envPath)
 }
 
 // GetConfiguredProviders returns a list of providers with API keys set
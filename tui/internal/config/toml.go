@@ -0,0 +1,302 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// tomlValue is a parsed value with the line it came from, for error messages.
+// Values are string, int64, float64, bool or []any of those.
+type tomlValue struct {
+	value any
+	line  int
+}
+
+// tomlTable holds the keys of one table, such as [profiles.nightly].
+type tomlTable map[string]tomlValue
+
+// parseTOML parses the subset of TOML the config file needs: [tables] with
+// dotted and quoted names, bare or quoted keys, and string, integer, float,
+// boolean and array values, with # comments. Tables are keyed by their
+// dotted path, the root table by "". Multi-line strings, inline tables,
+// arrays of tables, dotted keys and dates are rejected with an error rather
+// than misread.
+func parseTOML(data string) (map[string]tomlTable, error) {
+	tables := map[string]tomlTable{"": {}}
+	current := ""
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		number := i + 1
+		line := strings.TrimSpace(stripTOMLComment(lines[i]))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || strings.HasPrefix(line, "[[") {
+				return nil, fmt.Errorf("line %d: unsupported table header %s", number, line)
+			}
+			path, err := parseTOMLPath(strings.TrimSpace(line[1 : len(line)-1]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", number, err)
+			}
+			current = strings.Join(path, ".")
+			if _, ok := tables[current]; ok {
+				return nil, fmt.Errorf("line %d: table [%s] defined twice", number, current)
+			}
+			tables[current] = tomlTable{}
+			continue
+		}
+
+		// The key is parsed first, since a quoted key may contain "=".
+		key, rawValue, err := parseTOMLName(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+		rawValue, ok := strings.CutPrefix(strings.TrimSpace(rawValue), "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", number)
+		}
+		if len(key) != 1 {
+			return nil, fmt.Errorf("line %d: dotted keys are not supported; use a [table]", number)
+		}
+		rawValue = strings.TrimSpace(rawValue)
+		// Arrays may span lines until their closing bracket.
+		for strings.HasPrefix(rawValue, "[") && !tomlArrayClosed(rawValue) && i+1 < len(lines) {
+			i++
+			rawValue += " " + strings.TrimSpace(stripTOMLComment(lines[i]))
+		}
+		value, rest, err := parseTOMLValue(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", number, key[0], err)
+		}
+		if strings.TrimSpace(rest) != "" {
+			return nil, fmt.Errorf("line %d: %s: unexpected %q after value", number, key[0], strings.TrimSpace(rest))
+		}
+		if _, ok := tables[current][key[0]]; ok {
+			return nil, fmt.Errorf("line %d: %s set twice", number, key[0])
+		}
+		tables[current][key[0]] = tomlValue{value: value, line: number}
+	}
+	return tables, nil
+}
+
+// stripTOMLComment removes a # comment that is not inside a string.
+func stripTOMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// tomlArrayClosed reports whether the brackets of an array value balance
+// outside strings.
+func tomlArrayClosed(value string) bool {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+// parseTOMLPath splits a table name or key such as profiles."my run" into
+// its parts.
+func parseTOMLPath(text string) ([]string, error) {
+	parts, rest, err := parseTOMLName(text)
+	if err != nil {
+		return nil, err
+	}
+	if rest = strings.TrimSpace(rest); rest != "" {
+		return nil, fmt.Errorf("invalid name: unexpected %q", rest)
+	}
+	return parts, nil
+}
+
+// parseTOMLName parses the dotted name at the start of text and returns its
+// parts and the rest of text.
+func parseTOMLName(text string) ([]string, string, error) {
+	var parts []string
+	for {
+		text = strings.TrimSpace(text)
+		var part string
+		if strings.HasPrefix(text, `"`) || strings.HasPrefix(text, "'") {
+			value, rest, err := parseTOMLString(text)
+			if err != nil {
+				return nil, "", err
+			}
+			part, text = value, rest
+		} else {
+			end := strings.IndexFunc(text, func(r rune) bool {
+				return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-')
+			})
+			if end < 0 {
+				end = len(text)
+			}
+			part, text = text[:end], text[end:]
+		}
+		if part == "" {
+			return nil, "", fmt.Errorf("invalid name %q", text)
+		}
+		parts = append(parts, part)
+
+		rest := strings.TrimSpace(text)
+		if !strings.HasPrefix(rest, ".") {
+			return parts, text, nil
+		}
+		text = rest[1:]
+	}
+}
+
+// parseTOMLValue parses the value at the start of text and returns the rest.
+func parseTOMLValue(text string) (any, string, error) {
+	switch {
+	case text == "":
+		return nil, "", fmt.Errorf("missing value")
+	case text[0] == '"' || text[0] == '\'':
+		return parseTOMLString(text)
+	case text[0] == '[':
+		return parseTOMLArray(text)
+	case text[0] == '{':
+		return nil, "", fmt.Errorf("inline tables are not supported; use a [table]")
+	}
+
+	end := strings.IndexAny(text, ",] \t")
+	if end < 0 {
+		end = len(text)
+	}
+	token, rest := text[:end], text[end:]
+	switch token {
+	case "true":
+		return true, rest, nil
+	case "false":
+		return false, rest, nil
+	}
+	number := strings.ReplaceAll(token, "_", "")
+	if integer, err := strconv.ParseInt(number, 10, 64); err == nil {
+		return integer, rest, nil
+	}
+	if float, err := strconv.ParseFloat(number, 64); err == nil {
+		return float, rest, nil
+	}
+	return nil, "", fmt.Errorf("invalid value %q (strings need quotes)", token)
+}
+
+// parseTOMLString parses a "basic" or 'literal' string at the start of text.
+// Basic strings take TOML's escapes: \b \t \n \f \r \" \\ \uXXXX and
+// \UXXXXXXXX. Multi-line strings are not supported.
+func parseTOMLString(text string) (string, string, error) {
+	quote := text[0]
+	if strings.HasPrefix(text, strings.Repeat(string(quote), 3)) {
+		return "", "", fmt.Errorf("multi-line strings are not supported")
+	}
+	var value strings.Builder
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == quote:
+			return value.String(), text[i+1:], nil
+		case c < 0x20 && c != '\t' || c == 0x7f:
+			return "", "", fmt.Errorf("control character %q in string", c)
+		case c == '\\' && quote == '"':
+			r, size, err := parseTOMLEscape(text[i+1:])
+			if err != nil {
+				return "", "", err
+			}
+			value.WriteRune(r)
+			i += size
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string")
+}
+
+// parseTOMLEscape decodes the escape sequence after a backslash and returns
+// how many bytes it used.
+func parseTOMLEscape(text string) (rune, int, error) {
+	if text == "" {
+		return 0, 0, fmt.Errorf("unterminated string")
+	}
+	switch text[0] {
+	case 'b':
+		return '\b', 1, nil
+	case 't':
+		return '\t', 1, nil
+	case 'n':
+		return '\n', 1, nil
+	case 'f':
+		return '\f', 1, nil
+	case 'r':
+		return '\r', 1, nil
+	case '"', '\\':
+		return rune(text[0]), 1, nil
+	case 'u', 'U':
+		digits := 4
+		if text[0] == 'U' {
+			digits = 8
+		}
+		if len(text) <= digits {
+			return 0, 0, fmt.Errorf("invalid escape \\%s", text)
+		}
+		code, err := strconv.ParseUint(text[1:1+digits], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return 0, 0, fmt.Errorf("invalid escape \\%s", text[:1+digits])
+		}
+		return rune(code), 1 + digits, nil
+	}
+	_, size := utf8.DecodeRuneInString(text)
+	return 0, 0, fmt.Errorf("invalid escape \\%s", text[:size])
+}
+
+// parseTOMLArray parses an array, allowing a trailing comma, at the start of
+// text.
+func parseTOMLArray(text string) ([]any, string, error) {
+	values := []any{}
+	text = strings.TrimSpace(text[1:])
+	for {
+		if strings.HasPrefix(text, "]") {
+			return values, text[1:], nil
+		}
+		value, rest, err := parseTOMLValue(text)
+		if err != nil {
+			return nil, "", err
+		}
+		values = append(values, value)
+		text = strings.TrimSpace(rest)
+		switch {
+		case strings.HasPrefix(text, ","):
+			text = strings.TrimSpace(text[1:])
+		case strings.HasPrefix(text, "]"):
+		default:
+			return nil, "", fmt.Errorf("unterminated array")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTOMLStrings(t *testing.T) {
+	for input, want := range map[string]string{
+		`s = "plain"`:                 "plain",
+		`s = ""`:                      "",
+		`s = "tab\there\nnewline"`:    "tab\there\nnewline",
+		`s = "quote \" backslash \\"`: `quote " backslash \`,
+		`s = "\b\f\r"`:                "\b\f\r",
+		`s = "caf\u00E9 \U0001F600"`:  "café 😀",
+		`s = 'C:\Users\no\escapes'`:   `C:\Users\no\escapes`,
+		`s = "# not a comment" # one`: "# not a comment",
+	} {
+		tables, err := parseTOML(input)
+		if err != nil {
+			t.Errorf("%s: %v", input, err)
+			continue
+		}
+		if got := tables[""]["s"].value; got != want {
+			t.Errorf("%s = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestParseTOMLQuotedKeys(t *testing.T) {
+	for input, want := range map[string]string{
+		`"a=b" = 1`:             "a=b",
+		`'x = y' = 1`:           "x = y",
+		`"k # not a comment"=1`: "k # not a comment",
+		`"esc\"=" = 1`:          `esc"=`,
+		`plain= 1`:              "plain",
+	} {
+		tables, err := parseTOML(input)
+		if err != nil {
+			t.Errorf("%s: %v", input, err)
+			continue
+		}
+		if value, ok := tables[""][want]; !ok || value.value != int64(1) {
+			t.Errorf("%s: keys %v, want %q = 1", input, tables[""], want)
+		}
+	}
+
+	tables, err := parseTOML("[profiles.\"a=b\"]\nsamples = 2\n")
+	if err != nil || tables["profiles.a=b"]["samples"].value != int64(2) {
+		t.Errorf("expected the quoted table name kept whole, got %v, %v", tables, err)
+	}
+}
+
+func TestParseTOMLRejectsUnsupportedSyntax(t *testing.T) {
+	for input, want := range map[string]string{
+		// Go escapes that TOML does not have.
+		`s = "\x41"`:   `invalid escape \x`,
+		`s = "\a"`:     `invalid escape \a`,
+		`s = "\101"`:   `invalid escape \1`,
+		`s = "\u00"`:   `invalid escape \u00`,
+		`s = "\uD800"`: `invalid escape \uD800`,
+		`s = "\q"`:     `invalid escape \q`,
+		// Unsupported parts of TOML.
+		"s = \"\"\"\nmulti\nline\"\"\"": "multi-line strings are not supported",
+		"s = '''\nmulti\nline'''":       "multi-line strings are not supported",
+		`t = { a = 1 }`:                 "inline tables are not supported",
+		"[[profiles]]":                  "unsupported table header",
+		`a.b = 1`:                       "dotted keys are not supported",
+		`d = 1979-05-27`:                `invalid value "1979-05-27"`,
+		"s = \"raw\x01control\"":        "control character",
+		// Malformed keys.
+		`"a=b"`:       "expected key = value",
+		`"a" "b" = 1`: "expected key = value",
+		`"a=b = 1`:    "unterminated string",
+		`= 1`:         "invalid name",
+	} {
+		_, err := parseTOML(input)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: err = %v, want %q", input, err, want)
+		}
+	}
+}
@@ -124,9 +124,9 @@ func (m APIKeyPromptModel) validateKey(key string) tea.Cmd {
 
 func (m APIKeyPromptModel) saveKey(key string) (tea.Model, tea.Cmd) {
 	m.state.Config.APIKeys[m.provider.EnvKey] = key
-	if err := m.state.Config.SaveToEnv(); err != nil {
+	if err := m.state.Config.Save(); err != nil {
 		m.error = "Could not save API key: " + err.Error()
 		return m, nil
 	}
-	return NewExecutionModeModel(m.state), nil
+	return continueWithProvider(m.state)
 }
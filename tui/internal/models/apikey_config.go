@@ -85,6 +85,9 @@ func (m APIKeyConfigModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.validationStart = time.Now()
 					return m, m.validateKey(m.editingProvider, key)
 				}
+				// An empty key clears the provider's key when saved.
+				m.providers[m.selectedIndex].APIKey = ""
+				m.state.Config.APIKeys[m.editingProvider] = ""
 				m.editing = false
 				m.input.Blur()
 				return m, nil
@@ -124,9 +127,9 @@ func (m APIKeyConfigModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "tab", "right", "l":
 			// Continue to next screen if we have at least one key
 			if m.state.Config.HasAnyAPIKeys() {
-				// Always save to .env file
+				// Always save the keys, to the credential store or .env
 				m.state.Config.SaveToFile = true
-				m.state.Config.SaveToEnv()
+				m.state.Config.Save()
 				return NewExecutionModeModel(m.state), nil
 			}
 		}
@@ -169,7 +172,7 @@ func (m APIKeyConfigModel) View() tea.View {
 			}
 		}
 
-		lines = append(lines, "", styles.HelpStyle.Render("Enter: Confirm • Esc: Cancel"))
+		lines = append(lines, "", styles.HelpStyle.Render("Enter: Confirm (empty clears the key) • Esc: Cancel"))
 
 		modal := lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
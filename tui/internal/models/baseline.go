@@ -0,0 +1,112 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const regressionMarginEnv = "TUI_REGRESSION_MARGIN"
+
+// regressionMargin returns the pass@1 drop flagged against a baseline, from
+// TUI_REGRESSION_MARGIN (e.g. 0.1 for ten points) or the default.
+func regressionMargin() float64 {
+	if value := strings.TrimSpace(os.Getenv(regressionMarginEnv)); value != "" {
+		if margin, err := strconv.ParseFloat(value, 64); err == nil && margin >= 0 && margin <= 1 {
+			return margin
+		}
+	}
+	return analysis.DefaultRegressionMargin
+}
+
+type baselineCheckedMsg struct {
+	checks       []analysis.Regression
+	suiteWarning string
+	err          error
+}
+
+type baselineMarkedMsg struct {
+	models []string
+	err    error
+}
+
+// checkBaselineCmd compares the run stored in paths with the baseline of each
+// of its models. Models without a baseline, or whose baseline is this run, are
+// skipped.
+func checkBaselineCmd(paths []string, margin float64) tea.Cmd {
+	return func() tea.Msg {
+		dir, err := bridge.GetBenchmarksDir()
+		if err != nil {
+			return baselineCheckedMsg{err: err}
+		}
+		baselines, err := bridge.LoadBaselines(dir)
+		if err != nil || len(baselines) == 0 {
+			return baselineCheckedMsg{err: err}
+		}
+		candidate, err := loadEntries(paths)
+		if err != nil {
+			return baselineCheckedMsg{err: err}
+		}
+
+		own := make(map[string]bool, len(paths))
+		for _, path := range paths {
+			own[path] = true
+		}
+		loaded := make(map[string][]results.Entry)
+		var baseline []results.Entry
+		compared := slices.Clone(paths)
+		for _, model := range analysis.ModelIDs(candidate) {
+			path, ok := baselines[model]
+			if !ok || own[path] {
+				continue
+			}
+			if _, ok := loaded[path]; !ok {
+				file, err := results.Load(path)
+				if err != nil {
+					return baselineCheckedMsg{err: fmt.Errorf("baseline for %s: %w", model, err)}
+				}
+				loaded[path] = file.Entries
+				compared = append(compared, path)
+			}
+			for _, entry := range loaded[path] {
+				if entry.ModelID == model {
+					baseline = append(baseline, entry)
+				}
+			}
+		}
+		checks := analysis.CheckRegressions(baseline, candidate, margin)
+		if len(checks) == 0 {
+			return baselineCheckedMsg{}
+		}
+		return baselineCheckedMsg{checks: checks, suiteWarning: bridge.SuiteWarning(compared)}
+	}
+}
+
+// markBaselineCmd makes the run stored in paths the baseline of its models.
+func markBaselineCmd(paths []string) tea.Cmd {
+	return func() tea.Msg {
+		dir, err := bridge.GetBenchmarksDir()
+		if err != nil {
+			return baselineMarkedMsg{err: err}
+		}
+		var models []string
+		for _, path := range paths {
+			run, err := bridge.LoadBenchmarkRun(path)
+			if err != nil {
+				return baselineMarkedMsg{err: err}
+			}
+			if err := bridge.SetBaseline(dir, run); err != nil {
+				return baselineMarkedMsg{err: err}
+			}
+			models = append(models, run.Models...)
+		}
+		return baselineMarkedMsg{models: models}
+	}
+}
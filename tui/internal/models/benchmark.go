@@ -3,8 +3,10 @@ package models
 import (
 	"fmt"
 	"image/color"
+	"math"
 	"strings"
 	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/stats"
 	"svelte-bench/tui/internal/styles"
 	"time"
 
@@ -35,6 +37,12 @@ type BenchmarkModel struct {
 	completed    map[string]bool
 	scoreTotals  map[string]float64
 	scoreCounts  map[string]int
+	testStarted  map[string]time.Time
+	// overallInterval is recomputed as tests complete rather than on every
+	// animation frame, since the bootstrap resamples every sample.
+	overallInterval stats.Interval
+	terminal        terminalIntegration
+	notified        bool
 }
 
 // NewBenchmarkModel creates a new benchmark model
@@ -44,6 +52,10 @@ func NewBenchmarkModel(state *SharedState) BenchmarkModel {
 		"hello-world", "counter", "derived", "derived-by",
 		"each", "effect", "props", "snippets", "inspect",
 	}
+	if state.Profile != nil && len(state.Profile.Tests) > 0 {
+		testNames = state.Profile.Tests
+	}
+	samples := state.benchmarkConfig().Samples
 
 	modelIDs := selectedModelIDs(state.Model)
 	modelCount := len(modelIDs)
@@ -55,11 +67,11 @@ func NewBenchmarkModel(state *SharedState) BenchmarkModel {
 		if strings.HasPrefix(modelID, "o1-pro") {
 			samplesPerTest++
 		} else {
-			samplesPerTest += 10
+			samplesPerTest += samples
 		}
 	}
 	if samplesPerTest == 0 {
-		samplesPerTest = 10
+		samplesPerTest = samples
 	}
 
 	tests := make(map[string]*TestResult)
@@ -86,6 +98,8 @@ func NewBenchmarkModel(state *SharedState) BenchmarkModel {
 		completed:    make(map[string]bool),
 		scoreTotals:  make(map[string]float64),
 		scoreCounts:  make(map[string]int),
+		testStarted:  make(map[string]time.Time),
+		terminal:     loadTerminalIntegration(),
 	}
 }
 
@@ -132,6 +146,9 @@ func (m BenchmarkModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if !m.running {
 			m.running = true
 			m.startTime = time.Now()
+			m.state.RunStarted = m.startTime
+			m.state.TestDurations = make(map[string]time.Duration)
+			m.state.ResultFiles = nil
 		}
 		return m, m.tickCmd()
 
@@ -146,7 +163,7 @@ func (m BenchmarkModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case benchmarkErrorMsg:
 		m.running = false
 		m.state.Error = msg.err.Error()
-		return m, nil
+		return m, m.notifyCmd()
 
 	case benchmarkCompleteMsg:
 		if m.state.Error != "" {
@@ -154,11 +171,23 @@ func (m BenchmarkModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// incomplete run is visible instead of presenting partial results as
 			// a successful completion.
 			m.running = false
-			return m, nil
+			return m, m.notifyCmd()
 		}
 		m.running = false
 		m.state.Completed = true
-		return NewResultsModel(m.state), nil
+		if len(m.state.ResultFiles) == 0 {
+			// Runners that do not report their files leave them to be found
+			// by modification time.
+			m.state.ResultFiles = runResultFiles(m.state.RunStarted)
+		}
+		model := NewResultsModel(m.state)
+		model.width, model.height = m.width, m.height
+		model.terminal = m.terminal
+		return model, tea.Batch(
+			model.Init(),
+			m.notifyCmd(),
+			loadJUnitSettings().junitReportCmd(m.state.ResultFiles, m.state.TestDurations),
+		)
 
 	default:
 		// Tick for animations
@@ -179,6 +208,11 @@ func (m BenchmarkModel) View() tea.View {
 	if m.state.Error != "" {
 		fixedRows += 2
 	}
+	var warnings []string
+	if m.terminal.err != nil {
+		warnings = strings.Split(m.terminal.err.Error(), "\n")
+		fixedRows += 1 + len(warnings)
+	}
 	maxTestsShown := m.height - fixedRows
 	if maxTestsShown < 1 {
 		maxTestsShown = 1
@@ -214,10 +248,7 @@ func (m BenchmarkModel) View() tea.View {
 		barWidth = 16
 	}
 
-	percent := 0
-	if m.totalSamples > 0 {
-		percent = int((float64(m.currentCount) / float64(m.totalSamples)) * 100)
-	}
+	percent := m.percent()
 
 	progressLabel := lipgloss.NewStyle().
 		Foreground(styles.OrangeLight).
@@ -278,6 +309,13 @@ func (m BenchmarkModel) View() tea.View {
 			Render("Error: "+m.state.Error))
 	}
 
+	if len(warnings) > 0 {
+		sections = append(sections, "")
+		for _, warning := range warnings {
+			sections = append(sections, styles.WarningStyle.Render("⚠ "+warning))
+		}
+	}
+
 	// Help
 	sections = append(sections, "")
 	sections = append(sections, lipgloss.NewStyle().
@@ -290,7 +328,28 @@ func (m BenchmarkModel) View() tea.View {
 		MaxHeight(m.height).
 		Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
 
-	return newView(content)
+	view := newView(content)
+	m.terminal.decorate(&view, percent, m.state.Error != "", modelRunSummary(m.state.Model))
+	return view
+}
+
+func (m BenchmarkModel) percent() int {
+	if m.totalSamples <= 0 {
+		return 0
+	}
+	return int((float64(m.currentCount) / float64(m.totalSamples)) * 100)
+}
+
+// notifyCmd rings the terminal once per run, when it completes or fails.
+func (m *BenchmarkModel) notifyCmd() tea.Cmd {
+	if m.notified {
+		return nil
+	}
+	m.notified = true
+	if m.state.Error != "" {
+		return m.terminal.notifyCmd("svelte-bench failed", m.state.Error)
+	}
+	return m.terminal.notifyCmd("svelte-bench complete", modelRunSummary(m.state.Model))
 }
 
 func (m *BenchmarkModel) renderTest(test *TestResult) string {
@@ -377,7 +436,7 @@ func (m BenchmarkModel) renderOverallScore() string {
 	totalScore := 0.0
 	for _, name := range m.testOrder {
 		test := m.tests[name]
-		if (test.Status == StatusCompleted || test.Status == StatusFailed) && test.Current >= test.Total {
+		if test.finished() {
 			completed++
 			totalScore += test.PassAtOne
 		}
@@ -393,7 +452,24 @@ func (m BenchmarkModel) renderOverallScore() string {
 	return lipgloss.NewStyle().
 		Foreground(scoreColor(overall)).
 		Bold(true).
-		Render(fmt.Sprintf("Overall score: %.0f%% (%d/%d tests complete)", overall*100, completed, len(m.testOrder)))
+		Render(fmt.Sprintf("Overall score: %.0f%% (%d/%d tests complete) • 95%% CI %s",
+			overall*100, completed, len(m.testOrder), formatInterval(m.overallInterval)))
+}
+
+// updateOverallInterval bootstraps the overall score of the finished tests.
+func (m *BenchmarkModel) updateOverallInterval() {
+	outcomes := make([]stats.Proportion, 0, len(m.testOrder))
+	for _, name := range m.testOrder {
+		if test := m.tests[name]; test.finished() {
+			outcomes = append(outcomes, stats.Proportion{Correct: test.Correct, Total: test.Current})
+		}
+	}
+	m.overallInterval = stats.BootstrapMean(outcomes)
+}
+
+// formatInterval renders a 95% confidence interval as "[49–94%]".
+func formatInterval(interval stats.Interval) string {
+	return fmt.Sprintf("[%.0f–%.0f%%]", interval.Low*100, interval.High*100)
 }
 
 func scoreColor(score float64) color.Color {
@@ -446,6 +522,7 @@ func (m *BenchmarkModel) handleEvent(event bridge.BenchmarkEvent) {
 	key := modelTestKey(event.Model, event.Test)
 	switch event.Type {
 	case bridge.EventTestStart:
+		m.markTestStarted(key)
 		if test, ok := m.tests[event.Test]; ok {
 			test.Status = StatusRunning
 			test.RetryAfter = 0
@@ -453,6 +530,7 @@ func (m *BenchmarkModel) handleEvent(event bridge.BenchmarkEvent) {
 		}
 
 	case bridge.EventSampleProgress:
+		m.markTestStarted(key)
 		if test, ok := m.tests[event.Test]; ok {
 			test.Status = StatusRunning
 			previous := m.progress[key]
@@ -474,6 +552,10 @@ func (m *BenchmarkModel) handleEvent(event bridge.BenchmarkEvent) {
 			m.progress[key] = event.Total
 			if !m.completed[key] {
 				m.completed[key] = true
+				m.recordDuration(key)
+				// pass@1 is the fraction of correct samples, so the count is
+				// recovered from it for the confidence interval.
+				test.Correct += int(math.Round(event.PassAtOne * float64(event.Total)))
 				m.scoreTotals[event.Test] += event.PassAtOne
 				m.scoreCounts[event.Test]++
 			}
@@ -486,6 +568,7 @@ func (m *BenchmarkModel) handleEvent(event bridge.BenchmarkEvent) {
 					test.Status = StatusFailed
 				}
 			}
+			m.updateOverallInterval()
 		}
 
 	case bridge.EventRateLimit:
@@ -519,7 +602,9 @@ func (m *BenchmarkModel) handleEvent(event bridge.BenchmarkEvent) {
 			return
 		}
 
-		// Benchmark complete
+		// Benchmark complete. The results screen renders from the saved files
+		// and checks them against the streamed state kept here.
+		m.state.ResultFiles = event.SavedResultFiles()
 		m.state.Results = make([]TestResult, 0, len(m.tests))
 		for _, name := range m.testOrder {
 			m.state.Results = append(m.state.Results, *m.tests[name])
@@ -527,6 +612,25 @@ func (m *BenchmarkModel) handleEvent(event bridge.BenchmarkEvent) {
 	}
 }
 
+func (m *BenchmarkModel) markTestStarted(key string) {
+	if _, ok := m.testStarted[key]; !ok {
+		m.testStarted[key] = time.Now()
+	}
+}
+
+// recordDuration stores how long a model spent on a test, from its first
+// event to its completion.
+func (m *BenchmarkModel) recordDuration(key string) {
+	started, ok := m.testStarted[key]
+	if !ok {
+		return
+	}
+	if m.state.TestDurations == nil {
+		m.state.TestDurations = make(map[string]time.Duration)
+	}
+	m.state.TestDurations[key] = time.Since(started)
+}
+
 // completionError verifies that the event stream covered the full benchmark
 // suite before the TUI presents results. A failed category is still a valid
 // completed category when all of its samples ran; a queued/running category or
@@ -548,6 +652,22 @@ func (m BenchmarkModel) completionError() string {
 	return fmt.Sprintf("Benchmark incomplete; missing or unfinished tests: %s", strings.Join(missing, ", "))
 }
 
+// runResultFiles finds the files the runner saved for a run started at since.
+func runResultFiles(since time.Time) []string {
+	if since.IsZero() {
+		return nil
+	}
+	dir, err := bridge.GetBenchmarksDir()
+	if err != nil {
+		return nil
+	}
+	files, err := bridge.RunResultFiles(dir, since)
+	if err != nil {
+		return nil
+	}
+	return files
+}
+
 func selectedModelIDs(value string) []string {
 	parts := strings.Split(value, ",")
 	models := make([]string, 0, len(parts))
@@ -586,22 +706,7 @@ func (m BenchmarkModel) runBenchmark() tea.Cmd {
 	return func() tea.Msg {
 		// Start the actual TypeScript benchmark in a goroutine
 		go func() {
-			// Convert config to API keys map
-			apiKeys := make(map[string]string)
-			if m.state.Config != nil {
-				for key, value := range m.state.Config.APIKeys {
-					apiKeys[key] = value
-				}
-			}
-
-			config := bridge.BenchmarkConfig{
-				Provider: m.state.Provider,
-				Model:    m.state.Model,
-				APIKeys:  apiKeys,
-				Parallel: m.state.Parallel,
-				Madmax:   m.state.Madmax,
-				Samples:  10,
-			}
+			config := m.state.benchmarkConfig()
 
 			// Run benchmark and handle events
 			err := bridge.RunBenchmark(config, func(event bridge.BenchmarkEvent) {
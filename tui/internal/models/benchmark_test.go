@@ -1,11 +1,20 @@
 package models
 
 import (
+	"encoding/json"
+	"errors"
 	"math"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestBenchmarkViewShowsAllTestsAndPercentageScores(t *testing.T) {
@@ -96,7 +105,10 @@ func TestBenchmarkAcceptsFullyRunFailedCategories(t *testing.T) {
 		test.Status = StatusFailed
 		test.Current = test.Total
 	}
-	model.handleEvent(bridge.BenchmarkEvent{Type: bridge.EventComplete})
+	model.handleEvent(bridge.BenchmarkEvent{
+		Type:         bridge.EventComplete,
+		ResultsSaved: "/benchmarks/benchmark-results-2025-01-01T00-00-00.000Z.json",
+	})
 
 	if state.Error != "" {
 		t.Fatalf("expected fully executed failed categories to complete, got %q", state.Error)
@@ -104,4 +116,213 @@ func TestBenchmarkAcceptsFullyRunFailedCategories(t *testing.T) {
 	if len(state.Results) != len(model.testOrder) {
 		t.Fatalf("expected %d results, got %d", len(model.testOrder), len(state.Results))
 	}
+	if len(state.ResultFiles) != 1 || state.ResultFiles[0] != "/benchmarks/benchmark-results-2025-01-01T00-00-00.000Z.json" {
+		t.Fatalf("expected the saved results file to be recorded, got %v", state.ResultFiles)
+	}
+}
+
+// storedEntry builds a results entry whose first correct samples passed.
+func storedEntry(model, test string, correct, samples int) results.Entry {
+	entry := results.Entry{
+		TestName: test, Provider: "OpenAI", ModelID: model,
+		NumSamples: samples, NumCorrect: correct, Pass1: float64(correct) / float64(samples),
+	}
+	if correct > 0 {
+		entry.Pass10 = 1
+	}
+	for i := range samples {
+		entry.Samples = append(entry.Samples, results.Sample{Index: i, Code: "<p/>", Success: i < correct, Errors: []string{}})
+	}
+	return entry
+}
+
+// benchmarkProject makes a temporary project the working directory and
+// returns its benchmarks directory.
+func benchmarkProject(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	dir := filepath.Join(root, "benchmarks")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "package.json"), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(root)
+	t.Setenv(junitReportEnv, "")
+	return dir
+}
+
+// writeResultsFile saves entries as a results file in dir.
+func writeResultsFile(t *testing.T, dir, name string, entries ...results.Entry) string {
+	t.Helper()
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// runCmd runs cmd and the commands it batches, feeding their messages to
+// model. Commands returned by those updates are not run.
+func runCmd(model tea.Model, cmd tea.Cmd) tea.Model {
+	if cmd == nil {
+		return model
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, cmd := range batch {
+			model = runCmd(model, cmd)
+		}
+		return model
+	}
+	model, _ = model.Update(msg)
+	return model
+}
+
+// finishRun completes a live run that saved paths and returns the results
+// screen once its commands have run.
+func finishRun(t *testing.T, state *SharedState, paths ...string) ResultsModel {
+	t.Helper()
+	state.ResultFiles = paths
+	benchmark := NewBenchmarkModel(state)
+	model, cmd := benchmark.Update(benchmarkCompleteMsg{})
+	if _, ok := model.(ResultsModel); !ok {
+		t.Fatalf("expected the results screen after a completed run, got %T", model)
+	}
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 160, Height: 80})
+	return runCmd(model, cmd).(ResultsModel)
+}
+
+func TestBenchmarkCompletionLoadsTheSavedResults(t *testing.T) {
+	dir := benchmarkProject(t)
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json",
+		storedEntry("gpt-4o", "counter", 6, 10))
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o",
+		Results:  []TestResult{{TestName: "counter", Current: 10, Total: 10, Correct: 8, Passed: true, PassAtOne: 0.8}},
+	}
+
+	results := finishRun(t, state, path)
+	if len(results.entries) != 1 || results.entriesError != "" {
+		t.Fatalf("expected the saved entries to be loaded, got %d (error %q)", len(results.entries), results.entriesError)
+	}
+	if len(results.disagreements) != 1 || state.Results[0].Correct != 6 {
+		t.Fatalf("expected the file to replace the streamed counters, got %v and %#v", results.disagreements, state.Results[0])
+	}
+}
+
+func TestBenchmarkCompletionChecksTheBaseline(t *testing.T) {
+	dir := benchmarkProject(t)
+	writeResultsFile(t, dir, "benchmark-results-2025-01-01T00-00-00.000Z.json", storedEntry("gpt-4o", "counter", 10, 10))
+	baselines := `{"gpt-4o": "benchmark-results-2025-01-01T00-00-00.000Z.json"}`
+	if err := os.MkdirAll(filepath.Join(dir, bridge.BaselinesDir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(bridge.BaselinesPath(dir), []byte(baselines), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json", storedEntry("gpt-4o", "counter", 2, 10))
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o",
+		Results:  []TestResult{{TestName: "counter", Current: 10, Total: 10, Correct: 2, PassAtOne: 0.2}},
+	}
+
+	results := finishRun(t, state, path)
+	view := ansi.Strip(results.View().Content)
+	if !strings.Contains(view, "1 of 1 tests regressed") || !strings.Contains(view, "100% → 20%") {
+		t.Fatalf("expected the regression against the baseline, got:\n%s", view)
+	}
+}
+
+func TestBenchmarkCompletionShowsModelIntervals(t *testing.T) {
+	dir := benchmarkProject(t)
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json",
+		storedEntry("gpt-4o", "counter", 5, 10),
+		storedEntry("gpt-5", "counter", 6, 10))
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o,gpt-5",
+		Results:  []TestResult{{TestName: "counter", Current: 20, Total: 20, Correct: 11, Passed: true, PassAtOne: 0.55}},
+	}
+
+	results := finishRun(t, state, path)
+	view := ansi.Strip(results.View().Content)
+	for _, want := range []string{"MODELS", "≈ not significantly different from gpt-5", formatInterval(results.averageInterval)} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q after a live run, got:\n%s", want, view)
+		}
+	}
+}
+
+func TestBenchmarkCompletionComputesChosenPassAtK(t *testing.T) {
+	dir := benchmarkProject(t)
+	t.Setenv(passAtKEnv, "1,3")
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json", storedEntry("gpt-4o", "counter", 3, 10))
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o",
+		Results:  []TestResult{{TestName: "counter", Current: 10, Total: 10, Correct: 3, PassAtOne: 0.3, PassAtTen: 1}},
+	}
+
+	results := finishRun(t, state, path)
+	view := ansi.Strip(results.View().Content)
+	// pass@3 = 1 - C(7,3)/C(10,3) = 1 - 35/120.
+	if row := tableRow(view, "counter"); row == nil || row[len(row)-1] != "71%" {
+		t.Fatalf("expected pass@3 of 71%% from the saved samples, got %v in:\n%s", row, view)
+	}
+}
+
+func TestBenchmarkCompletionShowsModelMatrix(t *testing.T) {
+	dir := benchmarkProject(t)
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json",
+		storedEntry("gpt-4o", "counter", 5, 10),
+		storedEntry("gpt-4o", "effect", 3, 10),
+		storedEntry("gpt-5", "counter", 9, 10),
+		storedEntry("gpt-5", "effect", 0, 10))
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o,gpt-5",
+		Results: []TestResult{
+			{TestName: "counter", Current: 20, Total: 20, Correct: 14, Passed: true, PassAtOne: 0.7},
+			{TestName: "effect", Current: 20, Total: 20, Correct: 3, PassAtOne: 0.15},
+		},
+	}
+
+	results := finishRun(t, state, path)
+	if !results.showMatrix {
+		t.Fatal("expected a multi-model run to open on the model × test matrix")
+	}
+	view := ansi.Strip(results.View().Content)
+	if !strings.Contains(view, "OVERALL") || !strings.Contains(view, "90/100") || !strings.Contains(view, "0/0") {
+		t.Fatalf("expected the matrix cells of both models, got:\n%s", view)
+	}
+}
+
+func TestBenchmarkCompletionReportsInvalidJUnitThreshold(t *testing.T) {
+	dir := benchmarkProject(t)
+	report := filepath.Join(t.TempDir(), "junit.xml")
+	t.Setenv(junitReportEnv, report)
+	t.Setenv(junitThresholdEnv, "80%")
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json", storedEntry("gpt-4o", "counter", 8, 10))
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o",
+		Results:  []TestResult{{TestName: "counter", Current: 10, Total: 10, Correct: 8, Passed: true, PassAtOne: 0.8}},
+	}
+
+	results := finishRun(t, state, path)
+	view := ansi.Strip(results.View().Content)
+	if !strings.Contains(view, `Could not write JUnit report: TUI_JUNIT_THRESHOLD="80%" is not a number from 0 to 1`) {
+		t.Fatalf("expected the invalid threshold in the status line, got:\n%s", view)
+	}
+	if _, err := os.Stat(report); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected no report against the default threshold, got %v", err)
+	}
 }
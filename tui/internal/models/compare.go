@@ -0,0 +1,294 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
+	"svelte-bench/tui/internal/styles"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// compareSide is one run taking part in a comparison: a stored file or the
+// files written by the run that just finished.
+type compareSide struct {
+	label string
+	date  time.Time
+	paths []string
+}
+
+func storedCompareSide(run bridge.BenchmarkRun) compareSide {
+	return compareSide{
+		label: fmt.Sprintf("%s • %s", run.Date.Local().Format("2006-01-02 15:04"), strings.Join(run.Models, ", ")),
+		date:  run.Date,
+		paths: []string{run.Path},
+	}
+}
+
+type comparisonLoadedMsg struct {
+	comparison   analysis.Comparison
+	suiteWarning string
+	err          error
+}
+
+// CompareModel shows two runs side by side.
+type CompareModel struct {
+	state        *SharedState
+	base         compareSide
+	candidate    compareSide
+	comparison   analysis.Comparison
+	suiteWarning string
+	loading      bool
+	error        string
+	scrollOffset int
+	back         tea.Model
+	width        int
+	height       int
+}
+
+// NewCompareModel compares two runs, using the older one as the base.
+func NewCompareModel(state *SharedState, first, second compareSide, back tea.Model) CompareModel {
+	base, candidate := first, second
+	if candidate.date.Before(base.date) {
+		base, candidate = candidate, base
+	}
+	return CompareModel{
+		state:     state,
+		base:      base,
+		candidate: candidate,
+		loading:   true,
+		back:      back,
+		width:     80,
+		height:    24,
+	}
+}
+
+func (m CompareModel) Init() tea.Cmd {
+	base, candidate := m.base.paths, m.candidate.paths
+	return func() tea.Msg {
+		baseEntries, err := loadEntries(base)
+		if err != nil {
+			return comparisonLoadedMsg{err: err}
+		}
+		candidateEntries, err := loadEntries(candidate)
+		if err != nil {
+			return comparisonLoadedMsg{err: err}
+		}
+		return comparisonLoadedMsg{
+			comparison:   analysis.Compare(baseEntries, candidateEntries),
+			suiteWarning: bridge.SuiteWarning(append(slices.Clip(base), candidate...)),
+		}
+	}
+}
+
+// renderSuiteWarning renders a bridge.SuiteWarning wrapped to a screen of
+// width, or nothing when the runs shown together were scored against the
+// same tests.
+func renderSuiteWarning(warning string, width int) []string {
+	if warning == "" {
+		return nil
+	}
+	// The screens pad two columns on each side.
+	return []string{styles.WarningStyle.Width(max(20, width-4)).Render("⚠ " + warning)}
+}
+
+// loadEntries reads and concatenates the entries of several results files.
+func loadEntries(paths []string) ([]results.Entry, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no results file was saved for this run")
+	}
+	var entries []results.Entry
+	for _, path := range paths {
+		file, err := results.Load(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, file.Entries...)
+	}
+	return entries, nil
+}
+
+func (m CompareModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case comparisonLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.comparison = msg.comparison
+		m.suiteWarning = msg.suiteWarning
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			if m.back != nil {
+				return m.back, nil
+			}
+			model := NewHistoryModel(m.state)
+			return model, model.Init()
+		case "up":
+			if m.scrollOffset > 0 {
+				m.scrollOffset--
+			}
+		case "down":
+			if m.scrollOffset < len(m.bodyLines())-m.maxBodyLines() {
+				m.scrollOffset++
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m CompareModel) maxBodyLines() int {
+	return max(3, m.height-13)
+}
+
+func (m CompareModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("RUN COMPARISON")
+	lines = append(lines, styles.SectionLabelStyle.Render("HISTORY / COMPARE"), title, "")
+	lines = append(lines,
+		lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("A  ")+lipgloss.NewStyle().Foreground(styles.OrangeMid).Render(m.base.label),
+		lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("B  ")+lipgloss.NewStyle().Foreground(styles.OrangeMid).Render(m.candidate.label))
+	lines = append(lines, renderSuiteWarning(m.suiteWarning, m.width)...)
+	lines = append(lines, "")
+
+	if m.loading {
+		lines = append(lines, styles.ProgressTextStyle.Render("Loading runs..."))
+	} else if m.error != "" {
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	} else {
+		body := m.bodyLines()
+		end := min(len(body), m.scrollOffset+m.maxBodyLines())
+		lines = append(lines, body[m.scrollOffset:end]...)
+		if end < len(body) {
+			lines = append(lines, lipgloss.NewStyle().
+				Foreground(styles.GrayDim).
+				Render(fmt.Sprintf("... %d more lines", len(body)-end)))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("↑/↓: Scroll • ←: Back • Double Esc: Quit • Q/Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m CompareModel) bodyLines() []string {
+	lines := []string{styles.SectionLabelStyle.Render(fmt.Sprintf("  %-15s %10s %10s %7s %7s", "TEST", "A PASS@1", "B PASS@1", "DELTA", "P"))}
+	for _, row := range m.comparison.Rows {
+		lines = append(lines, renderComparisonRow(row))
+	}
+	lines = append(lines, "", lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(fmt.Sprintf("* change larger than sampling noise (Fisher exact p < %.2f)", stats.SignificanceLevel)))
+
+	if !m.comparison.SameModel {
+		return lines
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().
+		Foreground(styles.OrangeLight).
+		Bold(true).
+		Render("FLIPPED SAMPLES"))
+	flipped := false
+	for _, row := range m.comparison.Rows {
+		if len(row.Flips) == 0 {
+			continue
+		}
+		flipped = true
+		parts := make([]string, 0, len(row.Flips))
+		multipleModels := false
+		for _, flip := range row.Flips {
+			if flip.Model != row.Flips[0].Model {
+				multipleModels = true
+			}
+		}
+		for _, flip := range row.Flips {
+			label := fmt.Sprintf("#%d %s", flip.Index, flipLabel(flip))
+			if multipleModels {
+				label = flip.Model + " " + label
+			}
+			parts = append(parts, label)
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s",
+			lipgloss.NewStyle().Width(15).Foreground(styles.GrayMedium).Render(row.TestName),
+			strings.Join(parts, ", ")))
+	}
+	if !flipped {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayDim).Render("  No sample changed outcome"))
+	}
+	return lines
+}
+
+func flipLabel(flip analysis.SampleFlip) string {
+	if flip.After {
+		return lipgloss.NewStyle().Foreground(styles.OrangeSuccess).Render("fail→pass")
+	}
+	return lipgloss.NewStyle().Foreground(styles.OrangeError).Render("pass→fail")
+}
+
+func renderComparisonRow(row analysis.ComparisonRow) string {
+	name := lipgloss.NewStyle().Width(15).Foreground(styles.GrayMedium).Render(row.TestName)
+	base := renderOutcome(row.Base)
+	candidate := renderOutcome(row.Candidate)
+
+	delta := lipgloss.NewStyle().Width(7).Align(lipgloss.Right).Foreground(styles.GrayDim).Render("--")
+	pValue := lipgloss.NewStyle().Width(7).Align(lipgloss.Right).Foreground(styles.GrayDim).Render("--")
+	marker := " "
+	if row.Base.Present && row.Candidate.Present {
+		deltaColor := styles.GrayMedium
+		if row.Significant && row.Delta > 0 {
+			deltaColor = styles.OrangeSuccess
+		} else if row.Significant && row.Delta < 0 {
+			deltaColor = styles.OrangeError
+		}
+		delta = lipgloss.NewStyle().Width(7).Align(lipgloss.Right).Foreground(deltaColor).
+			Render(fmt.Sprintf("%+.0f%%", row.Delta*100))
+		pValue = lipgloss.NewStyle().Width(7).Align(lipgloss.Right).Foreground(styles.GrayMedium).
+			Render(fmt.Sprintf("%.3f", row.PValue))
+		if row.Significant {
+			marker = lipgloss.NewStyle().Foreground(deltaColor).Bold(true).Render("*")
+		}
+	}
+
+	return fmt.Sprintf("  %s %s %s %s %s %s", name, base, candidate, delta, pValue, marker)
+}
+
+func renderOutcome(outcome analysis.Outcome) string {
+	style := lipgloss.NewStyle().Width(10).Align(lipgloss.Right)
+	if !outcome.Present {
+		return style.Foreground(styles.GrayDim).Render("--")
+	}
+	return style.Foreground(scoreColor(outcome.PassAtOne())).
+		Render(fmt.Sprintf("%.0f%% (%d)", outcome.PassAtOne()*100, outcome.Samples))
+}
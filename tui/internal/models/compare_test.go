@@ -0,0 +1,95 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/config"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestHistoryComparesTwoMarkedRunsOldestFirst(t *testing.T) {
+	state := &SharedState{Config: &config.Config{APIKeys: map[string]string{}}}
+	history := NewHistoryModel(state)
+	updated, _ := history.Update(historyLoadedMsg{runs: []bridge.BenchmarkRun{
+		{Path: "new.json", Date: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), Models: []string{"gpt-5"}},
+		{Path: "old.json", Date: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), Models: []string{"gpt-5"}},
+	}})
+	history = updated.(HistoryModel)
+
+	updated, _ = history.Update(tea.KeyPressMsg{Code: 'k', Mod: tea.ModCtrl})
+	history = updated.(HistoryModel)
+	if history.compareWith == nil || !strings.Contains(history.View().Content, "pick the other run") {
+		t.Fatal("ctrl+k should mark the focused run for comparison")
+	}
+
+	updated, _ = history.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	updated, _ = updated.(HistoryModel).Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	compare, ok := updated.(CompareModel)
+	if !ok {
+		t.Fatalf("enter should open the comparison, got %T", updated)
+	}
+	if compare.base.paths[0] != "old.json" || compare.candidate.paths[0] != "new.json" {
+		t.Fatalf("expected the older run as base, got %v vs %v", compare.base.paths, compare.candidate.paths)
+	}
+
+	back, _ := compare.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if _, ok := back.(HistoryModel); !ok {
+		t.Fatalf("left should return to the history browser, got %T", back)
+	}
+}
+
+func TestCompareViewMarksSignificantChangesAndFlips(t *testing.T) {
+	model := NewCompareModel(&SharedState{}, compareSide{label: "A run"}, compareSide{label: "B run"}, nil)
+	model.height = 40
+	updated, _ := model.Update(comparisonLoadedMsg{comparison: analysis.Comparison{
+		SameModel: true,
+		Rows: []analysis.ComparisonRow{{
+			TestName:    "effect",
+			Base:        analysis.Outcome{Present: true, Correct: 10, Samples: 10},
+			Candidate:   analysis.Outcome{Present: true, Correct: 2, Samples: 10},
+			Delta:       -0.8,
+			PValue:      0.0007,
+			Significant: true,
+			Flips:       []analysis.SampleFlip{{Model: "gpt-5", Index: 3, Before: true}},
+		}},
+	}})
+
+	view := ansi.Strip(updated.(CompareModel).View().Content)
+	for _, want := range []string{"100% (10)", "20% (10)", "-80%", "*", "#3 pass→fail"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("comparison view missing %q", want)
+		}
+	}
+}
+
+func TestResultsCompareRequiresSavedFiles(t *testing.T) {
+	model := NewResultsModel(&SharedState{Provider: "openai", Model: "gpt-5"})
+	updated, _ := model.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if _, ok := updated.(ResultsModel); !ok {
+		t.Fatalf("compare without saved files should stay on results, got %T", updated)
+	}
+
+	model.state.ResultFiles = []string{"benchmark-results-x.json"}
+	updated, _ = model.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if picker, ok := updated.(HistoryModel); !ok || picker.compareWith == nil {
+		t.Fatalf("compare should open the run picker, got %T", updated)
+	}
+}
+
+func TestCompareViewWarnsAboutDifferentTestSuites(t *testing.T) {
+	model := NewCompareModel(&SharedState{}, compareSide{label: "A run"}, compareSide{label: "B run"}, nil)
+	model.height = 40
+	updated, _ := model.Update(comparisonLoadedMsg{
+		suiteWarning: "Runs were scored against different versions of tests they share: effect",
+	})
+	view := ansi.Strip(updated.(CompareModel).View().Content)
+	if !strings.Contains(view, "⚠ Runs were scored against different versions of tests they share: effect") {
+		t.Fatalf("expected the suite warning under the run labels:\n%s", view)
+	}
+}
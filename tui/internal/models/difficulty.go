@@ -0,0 +1,187 @@
+package models
+
+import (
+	"fmt"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/styles"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+type difficultyLoadedMsg struct {
+	entries      []results.Entry
+	files        int
+	suiteWarning string
+	err          error
+}
+
+// DifficultyModel ranks the test categories by how hard they are across every
+// stored model, to spot tests that are too easy or broken.
+type DifficultyModel struct {
+	state        *SharedState
+	report       analysis.DifficultyReport
+	sortBy       analysis.DifficultySort
+	files        int
+	suiteWarning string
+	back         tea.Model
+	loading      bool
+	loadingStart time.Time
+	error        string
+	width        int
+	height       int
+}
+
+// NewDifficultyModel creates the test difficulty screen. Going back returns to
+// back, or to provider selection when back is nil.
+func NewDifficultyModel(state *SharedState, back tea.Model) DifficultyModel {
+	return DifficultyModel{
+		state:        state,
+		back:         back,
+		loading:      true,
+		loadingStart: time.Now(),
+		width:        80,
+		height:       24,
+	}
+}
+
+func (m DifficultyModel) Init() tea.Cmd {
+	return loadDifficulty
+}
+
+func loadDifficulty() tea.Msg {
+	entries, paths, err := loadStoredEntries()
+	return difficultyLoadedMsg{entries: entries, files: len(paths), suiteWarning: bridge.SuiteWarning(paths), err: err}
+}
+
+func (m DifficultyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case difficultyLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.files = msg.files
+		m.suiteWarning = msg.suiteWarning
+		m.report = analysis.BuildDifficulty(msg.entries)
+		m.report.Sort(m.sortBy)
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			if m.back != nil {
+				return m.back, nil
+			}
+			model := NewProviderModelSelectModel(m.state)
+			return model, model.Init()
+		case "tab":
+			if m.sortBy == analysis.SortByDifficulty {
+				m.sortBy = analysis.SortByDiscrimination
+			} else {
+				m.sortBy = analysis.SortByDifficulty
+			}
+			m.report.Sort(m.sortBy)
+		}
+	}
+
+	return m, nil
+}
+
+func (m DifficultyModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("TEST DIFFICULTY")
+	lines = append(lines, styles.SectionLabelStyle.Render("HISTORY / TEST DIFFICULTY"), title, "")
+
+	switch {
+	case m.loading:
+		spinner := styles.SpinnerFrames[int(time.Since(m.loadingStart).Milliseconds()/100)%len(styles.SpinnerFrames)]
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.OrangePrimary).
+			Render(spinner+" Scanning benchmarks..."))
+	case m.error != "":
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	case len(m.report.Tests) == 0:
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("No stored results"))
+	default:
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(fmt.Sprintf("Latest result of %d models from %d files • sorted by %s", m.report.Models, m.files, m.sortBy)))
+		lines = append(lines, renderSuiteWarning(m.suiteWarning, m.width)...)
+		lines = append(lines, "")
+		header := fmt.Sprintf("  %2s %-14s %6s %8s %7s %8s %6s  %s", "#", "TEST", "MEAN", "VARIANCE", "ZERO", "DISCRIM", "N", "NOTE")
+		lines = append(lines, styles.SectionLabelStyle.Render(header))
+		for i, test := range m.report.Tests {
+			lines = append(lines, m.renderRow(i, test))
+		}
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render("MEAN: mean pass@1 over models • ZERO: share of models at 0% • DISCRIM: strongest third minus weakest third"))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("Tab: Sort by difficulty/discrimination • ←: Back • Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m DifficultyModel) renderRow(index int, test analysis.TestDifficulty) string {
+	right := func(width int) lipgloss.Style {
+		return lipgloss.NewStyle().Width(width).Align(lipgloss.Right)
+	}
+
+	zeroColor := styles.GrayMedium
+	if test.ZeroShare >= 0.5 {
+		zeroColor = styles.OrangeError
+	}
+	discrimination := right(8).Foreground(styles.GrayDim).Render("--")
+	if test.Discriminates {
+		discriminationColor := styles.OrangeSuccess
+		if test.Discrimination < 0.1 {
+			discriminationColor = styles.OrangeError
+		} else if test.Discrimination < 0.3 {
+			discriminationColor = styles.OrangeWarning
+		}
+		discrimination = right(8).
+			Foreground(discriminationColor).
+			Render(fmt.Sprintf("%+.0f%%", test.Discrimination*100))
+	}
+	note := ""
+	if verdict := test.Verdict(); verdict != "" {
+		note = lipgloss.NewStyle().Foreground(styles.OrangeWarning).Render(verdict)
+	}
+
+	return fmt.Sprintf("  %2d %s %s %s %s %s %s  %s",
+		index+1,
+		lipgloss.NewStyle().Width(14).Foreground(styles.GrayLight).Render(truncateText(test.TestName, 14)),
+		right(6).Foreground(scoreColor(test.Mean)).Render(fmt.Sprintf("%.0f%%", test.Mean*100)),
+		right(8).Foreground(styles.GrayMedium).Render(fmt.Sprintf("%.3f", test.Variance)),
+		right(7).Foreground(zeroColor).Render(fmt.Sprintf("%.0f%%", test.ZeroShare*100)),
+		discrimination,
+		right(6).Foreground(styles.GrayMedium).Render(fmt.Sprintf("%d", test.Models)),
+		note)
+}
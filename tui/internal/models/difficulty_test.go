@@ -0,0 +1,40 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestDifficultyViewFlagsTestsEveryModelFails(t *testing.T) {
+	var entries []results.Entry
+	for i, model := range []string{"a", "b", "c"} {
+		entries = append(entries,
+			results.Entry{Provider: "OpenAI", ModelID: model, TestName: "counter", Pass1: float64(i) / 2},
+			results.Entry{Provider: "OpenAI", ModelID: model, TestName: "inspect", Pass1: 0},
+		)
+	}
+
+	var model tea.Model = NewDifficultyModel(&SharedState{}, nil)
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	model, _ = model.Update(difficultyLoadedMsg{entries: entries, files: 3})
+
+	view := ansi.Strip(model.View().Content)
+	inspect := strings.Index(view, "inspect")
+	if inspect < 0 || inspect > strings.Index(view, "counter") {
+		t.Fatalf("expected the hardest test first:\n%s", view)
+	}
+	if !strings.Contains(view, "almost every model fails: check the test") {
+		t.Fatalf("expected a test every model fails to be flagged:\n%s", view)
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	view = ansi.Strip(model.View().Content)
+	if !strings.Contains(view, "sorted by discrimination") || strings.Index(view, "counter") > strings.Index(view, "inspect") {
+		t.Fatalf("expected the discriminating test first after Tab:\n%s", view)
+	}
+}
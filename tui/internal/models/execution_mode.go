@@ -58,8 +58,7 @@ func (m ExecutionModeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			m.state.Parallel = (m.selectedOption == 0)
 			m.state.Madmax = (m.selectedOption == 2)
-			model := NewModelSelectionModel(m.state)
-			return model, model.loadModels(model.providers[model.selectedProvider])
+			return continueWithMode(m.state)
 		}
 	}
 
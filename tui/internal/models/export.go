@@ -0,0 +1,211 @@
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/report"
+	"svelte-bench/tui/internal/styles"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+type exportDoneMsg struct {
+	path string
+	err  error
+}
+
+// ExportModel writes the per-model, per-test summary of a run to a file.
+type ExportModel struct {
+	state       *SharedState
+	paths       []string
+	runName     string
+	format      int
+	pathInput   textinput.Model
+	exporting   bool
+	saved       string
+	error       string
+	back        tea.Model
+	width       int
+	height      int
+	durations   map[string]time.Duration
+	benchmarks  string
+	defaultPath string
+}
+
+// NewExportModel exports the results stored in paths. Durations from the
+// live run are included when known. Going back returns to back.
+func NewExportModel(state *SharedState, paths []string, runName string, durations map[string]time.Duration, back tea.Model) ExportModel {
+	pathInput := textinput.New()
+	pathInput.SetWidth(70)
+	pathInputStyles := pathInput.Styles()
+	pathInputStyles.Focused.Prompt = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	pathInputStyles.Focused.Text = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	pathInputStyles.Blurred = pathInputStyles.Focused
+	pathInput.SetStyles(pathInputStyles)
+	pathInput.Focus()
+
+	benchmarks, err := bridge.GetBenchmarksDir()
+	if err != nil {
+		benchmarks = "benchmarks"
+	}
+
+	m := ExportModel{
+		state:      state,
+		paths:      paths,
+		runName:    runName,
+		pathInput:  pathInput,
+		back:       back,
+		width:      80,
+		height:     24,
+		durations:  durations,
+		benchmarks: benchmarks,
+	}
+	m.setFormat(0)
+	return m
+}
+
+func (m ExportModel) Init() tea.Cmd {
+	return nil
+}
+
+// setFormat switches format and updates the output path, swapping only the
+// extension when the path was edited.
+func (m *ExportModel) setFormat(index int) {
+	m.format = index
+	format := report.Formats[index]
+	defaultPath := report.DefaultPath(m.benchmarks, m.runName, format)
+	current := m.pathInput.Value()
+	if current == "" || current == m.defaultPath {
+		m.pathInput.SetValue(defaultPath)
+	} else {
+		m.pathInput.SetValue(strings.TrimSuffix(current, filepath.Ext(current)) + "." + string(format))
+	}
+	m.pathInput.CursorEnd()
+	m.defaultPath = defaultPath
+}
+
+func (m ExportModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.pathInput.SetWidth(min(70, max(20, m.width-20)))
+		return m, nil
+
+	case exportDoneMsg:
+		m.exporting = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.saved = msg.path
+		return m, nil
+
+	case tea.KeyPressMsg:
+		if m.exporting {
+			return m, nil
+		}
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			return m.back, nil
+		case "tab", "down":
+			m.setFormat((m.format + 1) % len(report.Formats))
+		case "shift+tab", "up":
+			m.setFormat((m.format + len(report.Formats) - 1) % len(report.Formats))
+		case "enter":
+			path := strings.TrimSpace(m.pathInput.Value())
+			if path == "" {
+				m.error = "enter an output path"
+				return m, nil
+			}
+			m.exporting = true
+			m.error = ""
+			m.saved = ""
+			return m, m.export(path, report.Formats[m.format])
+		default:
+			var cmd tea.Cmd
+			m.pathInput, cmd = m.pathInput.Update(msg)
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+func (m ExportModel) export(path string, format report.Format) tea.Cmd {
+	paths, durations := m.paths, m.durations
+	return func() tea.Msg {
+		entries, err := loadEntries(paths)
+		if err != nil {
+			return exportDoneMsg{err: err}
+		}
+		rows := report.Rows(entries)
+		for i := range rows {
+			rows[i].Duration = durations[modelTestKey(rows[i].Model, rows[i].Test)]
+		}
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		return exportDoneMsg{path: path, err: report.WriteFile(path, format, rows)}
+	}
+}
+
+func (m ExportModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("EXPORT RESULTS")
+	lines = append(lines, styles.SectionLabelStyle.Render("RESULTS / EXPORT"), title, "")
+
+	lines = append(lines, styles.SectionLabelStyle.Render("FORMAT"))
+	for i, format := range report.Formats {
+		label := fmt.Sprintf("  %s (.%s)", format.Label(), format)
+		if i == m.format {
+			label = lipgloss.NewStyle().
+				Foreground(styles.OrangePrimary).
+				Bold(true).
+				Render(fmt.Sprintf("> %s (.%s)", format.Label(), format))
+		} else {
+			label = lipgloss.NewStyle().Foreground(styles.GrayLight).Render(label)
+		}
+		lines = append(lines, label)
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("OUTPUT  ")+m.pathInput.View())
+	if len(m.durations) == 0 {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render("Durations are only known for runs timed by this session and are left empty."))
+	}
+
+	if m.exporting {
+		lines = append(lines, "", styles.ProgressTextStyle.Render("Exporting..."))
+	} else if m.error != "" {
+		lines = append(lines, "", styles.ErrorStyle.Render("Could not export: "+m.error))
+	} else if m.saved != "" {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(styles.OrangeSuccess).Render("Saved to "+m.saved))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("↑/↓/Tab: Format • Type: Edit path • Enter: Export • ←: Back • Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
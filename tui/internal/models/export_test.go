@@ -0,0 +1,56 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/config"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func TestExportWritesSummaryWithRunDurations(t *testing.T) {
+	dir := t.TempDir()
+	resultsPath := filepath.Join(dir, "benchmark-results-2025-10-17T19-39-14.181Z.json")
+	data := `[{"testName":"counter","provider":"OpenAI","modelId":"gpt-5","numSamples":1,"numCorrect":1,"pass1":1,"pass10":1,
+		"context":{"used":false,"content":""},"samples":[{"index":0,"code":"<p/>","success":true,"errors":[]}]}]`
+	if err := os.WriteFile(resultsPath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	state := &SharedState{Config: &config.Config{APIKeys: map[string]string{}}}
+	durations := map[string]time.Duration{modelTestKey("gpt-5", "counter"): 2 * time.Second}
+	export := NewExportModel(state, []string{resultsPath}, filepath.Base(resultsPath), durations, NewResultsModel(state))
+	if value := export.pathInput.Value(); !strings.HasSuffix(value, filepath.Join("exports", "summary-2025-10-17T19-39-14.181Z.csv")) {
+		t.Fatalf("unexpected default path %q", value)
+	}
+
+	updated, _ := export.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	export = updated.(ExportModel)
+	if !strings.HasSuffix(export.pathInput.Value(), ".md") {
+		t.Fatalf("switching format should switch the extension, got %q", export.pathInput.Value())
+	}
+
+	output := filepath.Join(dir, "out", "summary.md")
+	export.pathInput.SetValue(output)
+	updated, cmd := export.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should start the export")
+	}
+	updated, _ = updated.(ExportModel).Update(cmd())
+	export = updated.(ExportModel)
+	if export.error != "" || export.saved != output {
+		t.Fatalf("expected export to %s, got saved=%q error=%q", output, export.saved, export.error)
+	}
+
+	written, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(written), "| OpenAI | gpt-5 | counter | 100.0% | 100.0% | 1 | 1 | 2s |") {
+		t.Fatalf("unexpected export:\n%s", written)
+	}
+}
@@ -0,0 +1,228 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/styles"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// maxFailureClusters caps the failure modes listed for the focused group.
+const maxFailureClusters = 5
+
+type failuresLoadedMsg struct {
+	entries []results.Entry
+	err     error
+}
+
+// FailuresModel clusters the errors of a run's failed samples into failure
+// modes, per test or per model.
+type FailuresModel struct {
+	state        *SharedState
+	paths        []string
+	entries      []results.Entry
+	grouping     analysis.FailureGrouping
+	groups       []analysis.FailureGroup
+	selected     int
+	scrollOffset int
+	back         tea.Model
+	loading      bool
+	error        string
+	width        int
+	height       int
+}
+
+// NewFailuresModel loads the samples stored in paths. Going back returns to
+// back.
+func NewFailuresModel(state *SharedState, paths []string, back tea.Model) FailuresModel {
+	return FailuresModel{
+		state:   state,
+		paths:   paths,
+		back:    back,
+		loading: true,
+		width:   80,
+		height:  24,
+	}
+}
+
+func (m FailuresModel) Init() tea.Cmd {
+	paths := m.paths
+	return func() tea.Msg {
+		entries, err := loadEntries(paths)
+		return failuresLoadedMsg{entries: entries, err: err}
+	}
+}
+
+func (m FailuresModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case failuresLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.entries = msg.entries
+		m.regroup()
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			return m.back, nil
+		case "tab":
+			if m.grouping == analysis.GroupFailuresByTest {
+				m.grouping = analysis.GroupFailuresByModel
+			} else {
+				m.grouping = analysis.GroupFailuresByTest
+			}
+			m.regroup()
+		case "up":
+			if m.selected > 0 {
+				m.selected--
+				if m.selected < m.scrollOffset {
+					m.scrollOffset = m.selected
+				}
+			}
+		case "down":
+			if m.selected < len(m.groups)-1 {
+				m.selected++
+				if m.selected >= m.scrollOffset+m.maxVisible() {
+					m.scrollOffset = m.selected - m.maxVisible() + 1
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// regroup clusters the failures for the current grouping.
+func (m *FailuresModel) regroup() {
+	m.groups = analysis.ClusterFailures(m.entries, m.grouping)
+	m.selected = 0
+	m.scrollOffset = 0
+}
+
+// maxVisible leaves room for the failure modes of the focused group, which use
+// two rows each.
+func (m FailuresModel) maxVisible() int {
+	return max(3, m.height-16-2*maxFailureClusters)
+}
+
+func (m FailuresModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("FAILURE MODES")
+	lines = append(lines, styles.SectionLabelStyle.Render("RESULTS / FAILURES"), title, "")
+
+	switch {
+	case m.loading:
+		lines = append(lines, styles.ProgressTextStyle.Render("Loading samples..."))
+	case m.error != "":
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	case len(m.groups) == 0:
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.OrangeSuccess).Render("No failed samples in this run"))
+	default:
+		failures := 0
+		for _, group := range m.groups {
+			failures += group.Failures
+		}
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(fmt.Sprintf("%d failed samples • by %s", failures, m.grouping)), "")
+
+		endIdx := min(m.scrollOffset+m.maxVisible(), len(m.groups))
+		for i := m.scrollOffset; i < endIdx; i++ {
+			lines = append(lines, m.renderGroup(m.groups[i], i == m.selected))
+		}
+		if len(m.groups) > endIdx {
+			lines = append(lines, lipgloss.NewStyle().
+				Foreground(styles.GrayDim).
+				Render(fmt.Sprintf("  ... %d more", len(m.groups)-endIdx)))
+		}
+		lines = append(lines, "")
+		lines = append(lines, m.renderClusters(m.groups[m.selected])...)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("↑/↓: Focus • Tab: By test/model • ←: Back • Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m FailuresModel) renderGroup(group analysis.FailureGroup, focused bool) string {
+	prefix := "  "
+	nameStyle := lipgloss.NewStyle().Width(28).Foreground(styles.GrayLight)
+	if focused {
+		prefix = "> "
+		nameStyle = styles.SelectedRowStyle.Width(28)
+	}
+	modes := "modes"
+	if len(group.Clusters) == 1 {
+		modes = "mode"
+	}
+	return prefix + nameStyle.Render(truncateText(group.Name, 28)) + " " + lipgloss.NewStyle().
+		Foreground(styles.OrangeError).
+		Render(fmt.Sprintf("%d/%d failed", group.Failures, group.Samples)) + lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(fmt.Sprintf(" • %d %s", len(group.Clusters), modes))
+}
+
+// renderClusters lists the most common failure modes of group, each with the
+// share of failed samples it explains and an example sample.
+func (m FailuresModel) renderClusters(group analysis.FailureGroup) []string {
+	lines := []string{styles.SectionLabelStyle.Render("TOP FAILURE MODES • " + strings.ToUpper(group.Name))}
+	width := max(20, m.width-16)
+	for _, cluster := range group.Clusters[:min(len(group.Clusters), maxFailureClusters)] {
+		count := lipgloss.NewStyle().
+			Width(9).
+			Foreground(styles.OrangeError).
+			Bold(true).
+			Render(fmt.Sprintf("%d× %3.0f%%", cluster.Count, float64(cluster.Count)/float64(group.Failures)*100))
+		lines = append(lines, "  "+count+" "+lipgloss.NewStyle().
+			Foreground(styles.GrayLight).
+			Render(truncateText(cluster.Pattern, width)))
+
+		example := cluster.Example
+		source := fmt.Sprintf("%s sample #%d", example.Model, example.Sample)
+		if m.grouping == analysis.GroupFailuresByModel {
+			source = fmt.Sprintf("%s sample #%d", example.TestName, example.Sample)
+		}
+		message, _, _ := strings.Cut(example.Message, "\n")
+		if message == "" {
+			message = analysis.NoErrorPattern
+		}
+		lines = append(lines, strings.Repeat(" ", 12)+lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(truncateText("e.g. "+source+": "+message, width)))
+	}
+	if len(group.Clusters) > maxFailureClusters {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(fmt.Sprintf("  ... and %d rarer modes", len(group.Clusters)-maxFailureClusters)))
+	}
+	return lines
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestFailuresViewListsTopFailureModes(t *testing.T) {
+	failed := func(index int, message string) results.Sample {
+		return results.Sample{Index: index, Errors: []string{message}}
+	}
+	entries := []results.Entry{{
+		TestName: "derived-by",
+		ModelID:  "gpt-5",
+		Samples: []results.Sample{
+			failed(0, "tmp/samples/openai/Component.svelte:4:12 `$derived.by` is not a function"),
+			failed(1, "tmp/samples/openai/Component.svelte:9:3 `$derived.by` is not a function"),
+			failed(2, "expected 3 to be 4 // Object.is equality"),
+			{Index: 3, Success: true},
+		},
+	}}
+
+	var model tea.Model = NewFailuresModel(&SharedState{}, nil, nil)
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 140, Height: 50})
+	model, _ = model.Update(failuresLoadedMsg{entries: entries})
+
+	view := ansi.Strip(model.View().Content)
+	for _, want := range []string{
+		"3/4 failed",
+		"2×  67%   <file> `$derived.by` is not a function",
+		"e.g. gpt-5 sample #0: tmp/samples/openai/Component.svelte:4:12",
+		"1×  33%   expected <n> to be <n> // Object.is equality",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view:\n%s", want, view)
+		}
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if view := ansi.Strip(model.View().Content); !strings.Contains(view, "by model") || !strings.Contains(view, "e.g. derived-by sample #0") {
+		t.Fatalf("expected Tab to group failures by model:\n%s", view)
+	}
+}
@@ -0,0 +1,379 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/styles"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// includeV1Env opts the history into the legacy runs in benchmarks/v1, which
+// were scored against the first test suite.
+const includeV1Env = "TUI_INCLUDE_V1"
+
+// includeV1Runs reads TUI_INCLUDE_V1; unset means false.
+func includeV1Runs() (bool, error) {
+	value := strings.TrimSpace(os.Getenv(includeV1Env))
+	if value == "" {
+		return false, nil
+	}
+	include, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s=%q is not true or false", includeV1Env, value)
+	}
+	return include, nil
+}
+
+type historyLoadedMsg struct {
+	runs []bridge.BenchmarkRun
+	err  error
+}
+
+// HistoryModel browses stored benchmark-results files.
+type HistoryModel struct {
+	state        *SharedState
+	filterInput  textinput.Model
+	runs         []bridge.BenchmarkRun
+	filteredRuns []bridge.BenchmarkRun
+	selected     int
+	scrollOffset int
+	sortOrder    bridge.RunSortOrder
+	compareWith  *compareSide
+	compareBack  tea.Model
+	loading      bool
+	loadingStart time.Time
+	error        string
+	status       string
+	width        int
+	height       int
+}
+
+// NewHistoryModel creates the benchmark history browser.
+func NewHistoryModel(state *SharedState) HistoryModel {
+	filterInput := textinput.New()
+	filterInput.Placeholder = "Filter by provider or model..."
+	filterInput.SetWidth(60)
+	filterInputStyles := filterInput.Styles()
+	filterInputStyles.Focused.Prompt = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	filterInputStyles.Focused.Text = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	filterInputStyles.Blurred = filterInputStyles.Focused
+	filterInput.SetStyles(filterInputStyles)
+	filterInput.Focus()
+
+	return HistoryModel{
+		state:        state,
+		filterInput:  filterInput,
+		loading:      true,
+		loadingStart: time.Now(),
+		width:        80,
+		height:       24,
+	}
+}
+
+// NewComparePickerModel lets the user choose a stored run to compare with
+// side. Going back returns to back.
+func NewComparePickerModel(state *SharedState, side compareSide, back tea.Model) HistoryModel {
+	m := NewHistoryModel(state)
+	m.compareWith = &side
+	m.compareBack = back
+	return m
+}
+
+func (m HistoryModel) Init() tea.Cmd {
+	return loadHistory
+}
+
+func loadHistory() tea.Msg {
+	dir, err := bridge.GetBenchmarksDir()
+	if err != nil {
+		return historyLoadedMsg{err: err}
+	}
+	includeV1, err := includeV1Runs()
+	if err != nil {
+		return historyLoadedMsg{err: err}
+	}
+	runs, err := bridge.ListBenchmarkRuns(dir, includeV1)
+	return historyLoadedMsg{runs: runs, err: err}
+}
+
+func (m HistoryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		if m.width < 80 {
+			m.filterInput.SetWidth(max(12, m.width-20))
+		} else {
+			m.filterInput.SetWidth(60)
+		}
+		return m, nil
+
+	case baselineMarkedMsg:
+		if msg.err != nil {
+			m.status = "Could not set baseline: " + msg.err.Error()
+		} else {
+			m.status = "Baseline set for " + strings.Join(msg.models, ", ")
+		}
+		return m, nil
+
+	case historyLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.runs = msg.runs
+		m.applyFilter()
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			if m.compareBack != nil {
+				return m.compareBack, nil
+			}
+			if m.compareWith != nil {
+				m.compareWith = nil
+				return m, nil
+			}
+			model := NewProviderModelSelectModel(m.state)
+			return model, model.Init()
+		case "ctrl+k":
+			if m.selected >= len(m.filteredRuns) {
+				return m, nil
+			}
+			focused := storedCompareSide(m.filteredRuns[m.selected])
+			if m.compareWith == nil {
+				m.compareWith = &focused
+				return m, nil
+			}
+			model := NewCompareModel(m.state, *m.compareWith, focused, m)
+			return model, model.Init()
+		case "ctrl+b":
+			if m.selected >= len(m.filteredRuns) {
+				return m, nil
+			}
+			m.status = ""
+			return m, markBaselineCmd([]string{m.filteredRuns[m.selected].Path})
+		case "tab":
+			if m.sortOrder == bridge.SortRunsByDate {
+				m.sortOrder = bridge.SortRunsByScore
+			} else {
+				m.sortOrder = bridge.SortRunsByDate
+			}
+			m.applyFilter()
+		case "up":
+			if m.selected > 0 {
+				m.selected--
+				if m.selected < m.scrollOffset {
+					m.scrollOffset = m.selected
+				}
+			}
+		case "down":
+			if m.selected < len(m.filteredRuns)-1 {
+				m.selected++
+				if m.selected >= m.scrollOffset+m.maxVisible() {
+					m.scrollOffset = m.selected - m.maxVisible() + 1
+				}
+			}
+		case "enter":
+			if m.selected >= len(m.filteredRuns) {
+				return m, nil
+			}
+			if m.compareWith != nil {
+				model := NewCompareModel(m.state, *m.compareWith, storedCompareSide(m.filteredRuns[m.selected]), m)
+				return model, model.Init()
+			}
+			model := NewStoredResultsModel(m.state, m.filteredRuns[m.selected], &m)
+			return model, model.Init()
+		default:
+			var cmd tea.Cmd
+			m.filterInput, cmd = m.filterInput.Update(msg)
+			m.applyFilter()
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// applyFilter re-sorts the loaded runs and narrows them to the filter query.
+func (m *HistoryModel) applyFilter() {
+	bridge.SortBenchmarkRuns(m.runs, m.sortOrder)
+	m.filteredRuns = bridge.FilterBenchmarkRuns(m.runs, m.filterInput.Value())
+	m.selected = 0
+	m.scrollOffset = 0
+}
+
+func (m HistoryModel) maxVisible() int {
+	if m.status != "" {
+		return max(3, m.height-14)
+	}
+	return max(3, m.height-13)
+}
+
+func (m HistoryModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("BENCHMARK HISTORY")
+	lines = append(lines, styles.SectionLabelStyle.Render("HISTORY / STORED RUNS"), title)
+	if m.compareWith != nil {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.OrangeMid).
+			Render("Compare with "+m.compareWith.label+" — pick the other run"))
+	}
+	lines = append(lines, "")
+
+	inputLabel := lipgloss.NewStyle().
+		Foreground(styles.GrayMedium).
+		Render("FILTER  ")
+	lines = append(lines, inputLabel+m.filterInput.View())
+
+	sortLabel := "date"
+	if m.sortOrder == bridge.SortRunsByScore {
+		sortLabel = "score"
+	}
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(fmt.Sprintf("%d of %d runs • sorted by %s", len(m.filteredRuns), len(m.runs), sortLabel)))
+	if m.status != "" {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.OrangeMid).Render(m.status))
+	}
+	lines = append(lines, "")
+
+	if m.loading {
+		spinner := styles.SpinnerFrames[int(time.Since(m.loadingStart).Milliseconds()/100)%len(styles.SpinnerFrames)]
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.OrangePrimary).
+			Render(spinner+" Scanning benchmarks..."))
+	} else if m.error != "" {
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	} else if len(m.filteredRuns) == 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("No stored runs match"))
+	} else {
+		lines = append(lines, m.renderHeader())
+		endIdx := min(m.scrollOffset+m.maxVisible(), len(m.filteredRuns))
+		for i := m.scrollOffset; i < endIdx; i++ {
+			lines = append(lines, m.renderRun(m.filteredRuns[i], i == m.selected))
+		}
+		if len(m.filteredRuns) > endIdx {
+			lines = append(lines, lipgloss.NewStyle().
+				Foreground(styles.GrayDim).
+				Render(fmt.Sprintf("... %d more", len(m.filteredRuns)-endIdx)))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(m.helpText()))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m HistoryModel) helpText() string {
+	if m.compareWith != nil {
+		return "Type: Filter • ↑/↓: Focus • Tab: Sort date/score • Enter: Compare • ←: Cancel • Ctrl+C: Quit"
+	}
+	return "Type: Filter • ↑/↓: Focus • Tab: Sort date/score • Enter: Open • Ctrl+K: Compare • Ctrl+B: Baseline • ←: Back • Ctrl+C: Quit"
+}
+
+func (m HistoryModel) rowWidth() int {
+	return min(max(m.width-8, 60), 120)
+}
+
+func (m HistoryModel) modelColumnWidth() int {
+	// Date, provider, samples and score columns use 46 cells including gaps.
+	return max(12, m.rowWidth()-48)
+}
+
+func (m HistoryModel) renderHeader() string {
+	header := fmt.Sprintf("  %-16s  %-12s  %-*s  %7s  %6s",
+		"DATE", "PROVIDER", m.modelColumnWidth(), "MODELS", "SAMPLES", "PASS@1")
+	return styles.SectionLabelStyle.Render(header)
+}
+
+func (m HistoryModel) renderRun(run bridge.BenchmarkRun, focused bool) string {
+	date := "unknown"
+	if !run.Date.IsZero() {
+		date = run.Date.Local().Format("2006-01-02 15:04")
+	}
+	models := strings.Join(run.Models, ", ")
+	if run.Layout == results.LayoutV1 {
+		models = "[v1] " + models
+	}
+	models = truncateText(models, m.modelColumnWidth())
+
+	prefix := "  "
+	rowStyle := lipgloss.NewStyle().Width(m.rowWidth()).Foreground(styles.GrayLight)
+	if focused {
+		prefix = "> "
+		rowStyle = styles.SelectedRowStyle.Width(m.rowWidth())
+	}
+
+	score := lipgloss.NewStyle().
+		Width(6).
+		Align(lipgloss.Right).
+		Foreground(scoreColor(run.AveragePassAtOne)).
+		Render(fmt.Sprintf("%.0f%%", run.AveragePassAtOne*100))
+
+	content := fmt.Sprintf("%s%-16s  %-12s  %-*s  %7d  %s",
+		prefix, date, truncateText(run.Provider, 12), m.modelColumnWidth(), models, run.Samples, score)
+	return rowStyle.Render(content)
+}
+
+// storedTestResults converts stored entries into the per-test rows rendered
+// after a live run, averaging pass@1 across models like handleEvent does.
+func storedTestResults(entries []results.Entry) []TestResult {
+	order := make([]string, 0)
+	byTest := make(map[string]*TestResult)
+	counts := make(map[string]int)
+	for _, entry := range entries {
+		test, ok := byTest[entry.TestName]
+		if !ok {
+			test = &TestResult{TestName: entry.TestName}
+			byTest[entry.TestName] = test
+			order = append(order, entry.TestName)
+		}
+		test.Current += entry.NumSamples
+		test.Total += entry.NumSamples
+		test.Correct += entry.NumCorrect
+		test.PassAtOne += entry.Pass1
+		test.PassAtTen += entry.Pass10
+		counts[entry.TestName]++
+	}
+
+	results := make([]TestResult, 0, len(order))
+	for _, name := range order {
+		test := byTest[name]
+		test.PassAtOne /= float64(counts[name])
+		test.PassAtTen /= float64(counts[name])
+		test.Passed = test.PassAtOne > 0
+		test.Status = StatusCompleted
+		if !test.Passed {
+			test.Status = StatusFailed
+		}
+		results = append(results, *test)
+	}
+	return results
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/config"
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestHistoryOpensRunInResultsViewAndReturns(t *testing.T) {
+	state := &SharedState{Config: &config.Config{APIKeys: map[string]string{}}}
+	history := NewHistoryModel(state)
+	updated, _ := history.Update(historyLoadedMsg{runs: []bridge.BenchmarkRun{{
+		Path:     "/tmp/benchmark-results-2025-10-17T19-39-14.181Z.json",
+		Provider: "OpenRouter",
+		Models:   []string{"model-a", "model-b"},
+		Results: []results.Entry{
+			{TestName: "counter", ModelID: "model-a", NumSamples: 10, Pass1: 0.8},
+			{TestName: "counter", ModelID: "model-b", NumSamples: 10, Pass1: 0.4},
+			{TestName: "effect", ModelID: "model-a", NumSamples: 10, Pass1: 0},
+		},
+	}}})
+	history = updated.(HistoryModel)
+	if !strings.Contains(history.View().Content, "model-a, model-b") {
+		t.Fatal("history should list the models of each run")
+	}
+
+	updated, _ = history.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	stored, ok := updated.(ResultsModel)
+	if !ok {
+		t.Fatalf("enter should open the results view, got %T", updated)
+	}
+	if len(stored.state.Results) != 2 {
+		t.Fatalf("expected one row per test, got %#v", stored.state.Results)
+	}
+	if counter := stored.state.Results[0]; math.Abs(counter.PassAtOne-0.6) > 1e-9 || counter.Total != 20 {
+		t.Fatalf("expected averaged counter result, got %#v", counter)
+	}
+	if effect := stored.state.Results[1]; effect.Passed || effect.Status != StatusFailed {
+		t.Fatalf("expected zero-score test to be failed, got %#v", effect)
+	}
+	if state.Results != nil {
+		t.Fatal("opening a stored run should not overwrite the live run state")
+	}
+
+	updated, _ = stored.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if _, ok := updated.(HistoryModel); !ok {
+		t.Fatalf("left should return to the history browser, got %T", updated)
+	}
+}
+
+func TestHistoryIncludesV1RunsOnlyWhenAsked(t *testing.T) {
+	dir := benchmarkProject(t)
+	if err := os.Mkdir(filepath.Join(dir, "v1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeResultsFile(t, dir, "benchmark-results-2025-10-02T00-00-00.000Z.json", storedEntry("gpt-4o", "counter", 8, 10))
+	writeResultsFile(t, filepath.Join(dir, "v1"), "v1-benchmark-results-2025-05-03T19-47-53.331Z.json", storedEntry("gpt-4", "counter", 2, 10))
+
+	for value, want := range map[string]int{"": 1, "false": 1, "true": 2} {
+		t.Setenv(includeV1Env, value)
+		msg := loadHistory().(historyLoadedMsg)
+		if msg.err != nil || len(msg.runs) != want {
+			t.Errorf("%s=%q: got %d runs (%v), want %d", includeV1Env, value, len(msg.runs), msg.err, want)
+		}
+	}
+
+	t.Setenv(includeV1Env, "true")
+	var model tea.Model = NewHistoryModel(&SharedState{})
+	model, _ = model.Update(loadHistory())
+	if view := ansi.Strip(model.View().Content); !strings.Contains(view, "[v1] gpt-4") {
+		t.Errorf("expected the v1 run to be marked, got:\n%s", view)
+	}
+
+	t.Setenv(includeV1Env, "sometimes")
+	if msg := loadHistory().(historyLoadedMsg); msg.err == nil || !strings.Contains(msg.err.Error(), includeV1Env) {
+		t.Errorf("expected an invalid %s to be reported, got %v", includeV1Env, msg.err)
+	}
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"svelte-bench/tui/internal/report"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const (
+	junitReportEnv    = "TUI_JUNIT_REPORT"
+	junitThresholdEnv = "TUI_JUNIT_THRESHOLD"
+)
+
+// junitSettings controls the JUnit XML report written after a live run. It is
+// off unless TUI_JUNIT_REPORT names the output file; TUI_JUNIT_THRESHOLD sets
+// the pass@1 below which a test case fails.
+type junitSettings struct {
+	path      string
+	threshold float64
+	// err reports an invalid TUI_JUNIT_THRESHOLD, which stops the report from
+	// being written rather than grading it against a threshold nobody chose.
+	err error
+}
+
+func loadJUnitSettings() junitSettings {
+	settings := junitSettings{
+		path:      strings.TrimSpace(os.Getenv(junitReportEnv)),
+		threshold: report.DefaultJUnitThreshold,
+	}
+	if value := strings.TrimSpace(os.Getenv(junitThresholdEnv)); value != "" {
+		threshold, err := strconv.ParseFloat(value, 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			settings.err = fmt.Errorf("%s=%q is not a number from 0 to 1", junitThresholdEnv, value)
+		} else {
+			settings.threshold = threshold
+		}
+	}
+	return settings
+}
+
+type junitWrittenMsg struct {
+	path string
+	err  error
+}
+
+// junitReportCmd writes the JUnit report for the files a run saved, using the
+// per-test durations measured while it ran.
+func (s junitSettings) junitReportCmd(paths []string, durations map[string]time.Duration) tea.Cmd {
+	if s.path == "" {
+		return nil
+	}
+	if s.err != nil {
+		return func() tea.Msg { return junitWrittenMsg{path: s.path, err: s.err} }
+	}
+	return func() tea.Msg {
+		entries, err := loadEntries(paths)
+		if err != nil {
+			return junitWrittenMsg{path: s.path, err: err}
+		}
+		junit := report.BuildJUnit(entries, s.threshold, func(model, test string) time.Duration {
+			return durations[modelTestKey(model, test)]
+		})
+		return junitWrittenMsg{path: s.path, err: report.WriteJUnitFile(s.path, junit)}
+	}
+}
@@ -0,0 +1,376 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/styles"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+const leaderboardTestColumnWidth = 8
+
+type leaderboardLoadedMsg struct {
+	entries      []results.Entry
+	files        int
+	suiteWarning string
+	err          error
+}
+
+// LeaderboardModel ranks every provider/model found in the stored results.
+type LeaderboardModel struct {
+	state        *SharedState
+	filterInput  textinput.Model
+	entries      []results.Entry
+	files        int
+	suiteWarning string
+	board        analysis.Leaderboard
+	rows         []analysis.LeaderboardRow
+	mode         analysis.LeaderboardMode
+	sortColumn   int
+	selected     int
+	scrollOffset int
+	back         tea.Model
+	loading      bool
+	loadingStart time.Time
+	error        string
+	width        int
+	height       int
+}
+
+// NewLeaderboardModel creates the leaderboard screen. Going back returns to
+// back, or to provider selection when back is nil.
+func NewLeaderboardModel(state *SharedState, back tea.Model) LeaderboardModel {
+	filterInput := textinput.New()
+	filterInput.Placeholder = "Filter by provider or model..."
+	filterInput.SetWidth(60)
+	filterInputStyles := filterInput.Styles()
+	filterInputStyles.Focused.Prompt = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	filterInputStyles.Focused.Text = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	filterInputStyles.Blurred = filterInputStyles.Focused
+	filterInput.SetStyles(filterInputStyles)
+	filterInput.Focus()
+
+	return LeaderboardModel{
+		state:        state,
+		filterInput:  filterInput,
+		back:         back,
+		loading:      true,
+		loadingStart: time.Now(),
+		width:        80,
+		height:       24,
+	}
+}
+
+func (m LeaderboardModel) Init() tea.Cmd {
+	return loadLeaderboard
+}
+
+func loadLeaderboard() tea.Msg {
+	entries, paths, err := loadStoredEntries()
+	return leaderboardLoadedMsg{entries: entries, files: len(paths), suiteWarning: bridge.SuiteWarning(paths), err: err}
+}
+
+// loadStoredEntries reads the entries of every stored results file, without
+// their samples, and returns them with the paths of the files read. The v1
+// runs are left out: they share test names with the current suite, so
+// aggregating them would silently mix two suites. So are runs prompted with a
+// context file, which merge.ts also keeps apart from the plain runs.
+func loadStoredEntries() ([]results.Entry, []string, error) {
+	dir, err := bridge.GetBenchmarksDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	runs, err := bridge.ListBenchmarkRuns(dir, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []results.Entry
+	paths := make([]string, 0, len(runs))
+	for _, run := range runs {
+		if usedContext(run.Results) {
+			continue
+		}
+		entries = append(entries, run.Results...)
+		paths = append(paths, run.Path)
+	}
+	return entries, paths, nil
+}
+
+// usedContext reports whether a run was prompted with a context file.
+func usedContext(entries []results.Entry) bool {
+	for _, entry := range entries {
+		if entry.Context.Used {
+			return true
+		}
+	}
+	return false
+}
+
+func (m LeaderboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		if m.width < 80 {
+			m.filterInput.SetWidth(max(12, m.width-20))
+		} else {
+			m.filterInput.SetWidth(60)
+		}
+		return m, nil
+
+	case leaderboardLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.entries = msg.entries
+		m.files = msg.files
+		m.suiteWarning = msg.suiteWarning
+		m.rebuild()
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			if m.back != nil {
+				return m.back, nil
+			}
+			model := NewProviderModelSelectModel(m.state)
+			return model, model.Init()
+		case "enter":
+			if m.selected < len(m.rows) {
+				row := m.rows[m.selected]
+				model := NewTrendsModel(m.state, analysis.ModelKey(row.Provider, row.ModelID), m)
+				model.width, model.height = m.width, m.height
+				return model, model.Init()
+			}
+		case "ctrl+t":
+			if m.mode == analysis.LeaderboardLatest {
+				m.mode = analysis.LeaderboardMean
+			} else {
+				m.mode = analysis.LeaderboardLatest
+			}
+			m.rebuild()
+		case "tab":
+			m.sortColumn = (m.sortColumn + 1) % (3 + len(m.board.Tests))
+			m.applyFilter()
+		case "shift+tab":
+			columns := 3 + len(m.board.Tests)
+			m.sortColumn = (m.sortColumn + columns - 1) % columns
+			m.applyFilter()
+		case "up":
+			if m.selected > 0 {
+				m.selected--
+				if m.selected < m.scrollOffset {
+					m.scrollOffset = m.selected
+				}
+			}
+		case "down":
+			if m.selected < len(m.rows)-1 {
+				m.selected++
+				if m.selected >= m.scrollOffset+m.maxVisible() {
+					m.scrollOffset = m.selected - m.maxVisible() + 1
+				}
+			}
+		default:
+			var cmd tea.Cmd
+			m.filterInput, cmd = m.filterInput.Update(msg)
+			m.applyFilter()
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// rebuild recomputes the leaderboard for the current mode.
+func (m *LeaderboardModel) rebuild() {
+	m.board = analysis.BuildLeaderboard(m.entries, m.mode)
+	if m.sortColumn >= 3+len(m.board.Tests) {
+		m.sortColumn = 0
+	}
+	m.applyFilter()
+}
+
+// applyFilter re-sorts the leaderboard and narrows it to the filter query.
+func (m *LeaderboardModel) applyFilter() {
+	sortBy, test := m.sortKey()
+	m.board.Sort(sortBy, test)
+	m.rows = m.board.Filter(m.filterInput.Value())
+	m.selected = 0
+	m.scrollOffset = 0
+}
+
+// sortKey maps the sort column to overall, model, samples, then one column per
+// test category.
+func (m LeaderboardModel) sortKey() (analysis.LeaderboardSort, string) {
+	switch m.sortColumn {
+	case 0:
+		return analysis.SortByOverall, ""
+	case 1:
+		return analysis.SortByModel, ""
+	case 2:
+		return analysis.SortBySamples, ""
+	default:
+		return analysis.SortByTest, m.board.Tests[m.sortColumn-3]
+	}
+}
+
+func (m LeaderboardModel) sortLabel() string {
+	sortBy, test := m.sortKey()
+	switch sortBy {
+	case analysis.SortByModel:
+		return "model"
+	case analysis.SortBySamples:
+		return "samples"
+	case analysis.SortByTest:
+		return test
+	default:
+		return "overall"
+	}
+}
+
+func (m LeaderboardModel) maxVisible() int {
+	return max(3, m.height-15)
+}
+
+func (m LeaderboardModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("LEADERBOARD")
+	lines = append(lines, styles.SectionLabelStyle.Render("HISTORY / LEADERBOARD"), title, "")
+
+	inputLabel := lipgloss.NewStyle().
+		Foreground(styles.GrayMedium).
+		Render("FILTER  ")
+	lines = append(lines, inputLabel+m.filterInput.View())
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(fmt.Sprintf("%d of %d models from %d files • %s • sorted by %s",
+			len(m.rows), len(m.board.Rows), m.files, m.mode, m.sortLabel())))
+	lines = append(lines, renderSuiteWarning(m.suiteWarning, m.width)...)
+	lines = append(lines, "")
+
+	if m.loading {
+		spinner := styles.SpinnerFrames[int(time.Since(m.loadingStart).Milliseconds()/100)%len(styles.SpinnerFrames)]
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.OrangePrimary).
+			Render(spinner+" Scanning benchmarks..."))
+	} else if m.error != "" {
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	} else if len(m.rows) == 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("No models match"))
+	} else {
+		lines = append(lines, m.renderHeader())
+		endIdx := min(m.scrollOffset+m.maxVisible(), len(m.rows))
+		for i := m.scrollOffset; i < endIdx; i++ {
+			lines = append(lines, m.renderRow(i, m.rows[i], i == m.selected))
+		}
+		if len(m.rows) > endIdx {
+			lines = append(lines, lipgloss.NewStyle().
+				Foreground(styles.GrayDim).
+				Render(fmt.Sprintf("... %d more", len(m.rows)-endIdx)))
+		}
+		lines = append(lines, "", m.renderSampleCounts(m.rows[m.selected]))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("Type: Filter • ↑/↓: Focus • Tab: Sort column • Enter: Trend • Ctrl+T: Latest/mean • ←: Back • Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m LeaderboardModel) modelColumnWidth() int {
+	// Rank, overall and samples use 18 cells; the rest goes to test columns.
+	available := m.width - 8 - 18 - len(m.board.Tests)*(leaderboardTestColumnWidth+1)
+	return min(max(16, available), 36)
+}
+
+func (m LeaderboardModel) renderHeader() string {
+	header := fmt.Sprintf("  %4s %-*s %7s %6s", "#", m.modelColumnWidth(), "PROVIDER / MODEL", "OVERALL", "N")
+	for _, test := range m.board.Tests {
+		header += fmt.Sprintf(" %*s", leaderboardTestColumnWidth, strings.ToUpper(truncateText(test, leaderboardTestColumnWidth)))
+	}
+	return styles.SectionLabelStyle.Render(header)
+}
+
+func (m LeaderboardModel) renderRow(index int, row analysis.LeaderboardRow, focused bool) string {
+	prefix := "  "
+	nameStyle := lipgloss.NewStyle().Width(m.modelColumnWidth()).Foreground(styles.GrayLight)
+	if focused {
+		prefix = "> "
+		nameStyle = styles.SelectedRowStyle.Width(m.modelColumnWidth())
+	}
+
+	name := nameStyle.Render(truncateText(row.Provider+" / "+row.ModelID, m.modelColumnWidth()))
+	overall := lipgloss.NewStyle().
+		Width(7).
+		Align(lipgloss.Right).
+		Foreground(scoreColor(row.Overall)).
+		Render(fmt.Sprintf("%.0f%%", row.Overall*100))
+	samples := lipgloss.NewStyle().
+		Width(6).
+		Align(lipgloss.Right).
+		Foreground(styles.GrayMedium).
+		Render(fmt.Sprintf("%d", row.Samples))
+
+	line := fmt.Sprintf("%s%4d %s %s %s", prefix, index+1, name, overall, samples)
+	for _, test := range m.board.Tests {
+		cellStyle := lipgloss.NewStyle().Width(leaderboardTestColumnWidth).Align(lipgloss.Right)
+		cell, ok := row.Cells[test]
+		if !ok {
+			line += " " + cellStyle.Foreground(styles.GrayDim).Render("--")
+			continue
+		}
+		line += " " + cellStyle.Foreground(scoreColor(cell.PassAtOne)).Render(fmt.Sprintf("%.0f%%", cell.PassAtOne*100))
+	}
+	return line
+}
+
+// renderSampleCounts lists the samples (and runs, when averaging) behind each
+// score of the focused row.
+func (m LeaderboardModel) renderSampleCounts(row analysis.LeaderboardRow) string {
+	parts := make([]string, 0, len(m.board.Tests))
+	for _, test := range m.board.Tests {
+		cell, ok := row.Cells[test]
+		if !ok {
+			continue
+		}
+		part := fmt.Sprintf("%s n=%d", test, cell.Samples)
+		if m.mode == analysis.LeaderboardMean {
+			part += fmt.Sprintf(" over %d runs", cell.Runs)
+		}
+		parts = append(parts, part)
+	}
+
+	lastRun := "unknown"
+	if !row.LastRun.IsZero() {
+		lastRun = row.LastRun.Local().Format("2006-01-02 15:04")
+	}
+	return lipgloss.NewStyle().
+		Foreground(styles.GrayMedium).
+		Width(max(20, m.width-8)).
+		Render(fmt.Sprintf("%s • last run %s • %s", row.ModelID, lastRun, strings.Join(parts, " • ")))
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/config"
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestLeaderboardTogglesModeAndSortColumn(t *testing.T) {
+	state := &SharedState{Config: &config.Config{APIKeys: map[string]string{}}}
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	leaderboard := NewLeaderboardModel(state, nil)
+	updated, _ := leaderboard.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	updated, _ = updated.(LeaderboardModel).Update(leaderboardLoadedMsg{files: 2, entries: []results.Entry{
+		{Provider: "OpenAI", ModelID: "gpt-5", TestName: "counter", NumSamples: 10, Pass1: 0.2, Timestamp: at},
+		{Provider: "OpenAI", ModelID: "gpt-5", TestName: "counter", NumSamples: 10, Pass1: 1.0, Timestamp: at.Add(time.Hour)},
+	}})
+	leaderboard = updated.(LeaderboardModel)
+
+	view := ansi.Strip(leaderboard.View().Content)
+	if !strings.Contains(view, "OpenAI / gpt-5") || !strings.Contains(view, "100%") || !strings.Contains(view, "counter n=10") {
+		t.Fatalf("expected latest counter score with its sample count, got:\n%s", view)
+	}
+
+	updated, _ = leaderboard.Update(tea.KeyPressMsg{Code: 't', Mod: tea.ModCtrl})
+	leaderboard = updated.(LeaderboardModel)
+	view = ansi.Strip(leaderboard.View().Content)
+	if !strings.Contains(view, "mean of all runs") || !strings.Contains(view, "60%") || !strings.Contains(view, "counter n=20 over 2 runs") {
+		t.Fatalf("expected averaged counter score, got:\n%s", view)
+	}
+
+	for range 3 {
+		updated, _ = leaderboard.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+		leaderboard = updated.(LeaderboardModel)
+	}
+	if label := leaderboard.sortLabel(); label != "counter" {
+		t.Fatalf("expected the fourth sort column to be the counter test, got %q", label)
+	}
+
+	back, _ := leaderboard.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if _, ok := back.(ProviderModelSelectModel); !ok {
+		t.Fatalf("left should return to provider selection, got %T", back)
+	}
+}
+
+func TestStoredEntriesLeaveOutContextRuns(t *testing.T) {
+	dir := benchmarkProject(t)
+	plain := writeResultsFile(t, dir, "benchmark-results-2025-10-02T00-00-00.000Z.json", storedEntry("gpt-4o", "counter", 8, 10))
+	withContext := storedEntry("gpt-4o", "counter", 10, 10)
+	withContext.Context = results.Context{Used: true, Filename: "llms-small.txt"}
+	writeResultsFile(t, dir, "benchmark-results-with-context-2025-10-03T00-00-00.000Z.json", withContext)
+
+	entries, paths, err := loadStoredEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].NumCorrect != 8 || len(paths) != 1 || paths[0] != plain {
+		t.Fatalf("expected only the run without context, got %d entries from %v", len(entries), paths)
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"os"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/styles"
+
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/lipgloss/v2"
+)
+
+const passAtKEnv = "TUI_PASS_AT_K"
+
+// passAtKValues returns the k values the results screen shows: the ones chosen
+// this session, TUI_PASS_AT_K (e.g. "1,5,10"), or the runner's pass@1 and
+// pass@10.
+func passAtKValues(state *SharedState) []int {
+	if len(state.PassAtK) > 0 {
+		return state.PassAtK
+	}
+	if value := strings.TrimSpace(os.Getenv(passAtKEnv)); value != "" {
+		if ks, err := analysis.ParseKValues(value); err == nil {
+			return ks
+		}
+	}
+	return analysis.DefaultKValues
+}
+
+func newKInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "1,5,10"
+	input.SetWidth(30)
+	inputStyles := input.Styles()
+	inputStyles.Focused.Prompt = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	inputStyles.Focused.Text = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	inputStyles.Blurred = inputStyles.Focused
+	input.SetStyles(inputStyles)
+	return input
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/config"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// defaultSamples is the number of samples per test when no profile sets one.
+const defaultSamples = 10
+
+// profileProvider finds the provider a profile names, by runner ID such as
+// "openrouter", display name or API key variable.
+func profileProvider(cfg *config.Config, profile config.Profile) (config.Provider, error) {
+	for _, provider := range cfg.GetAllProvidersWithKeys() {
+		if strings.EqualFold(profile.Provider, bridge.ConvertProviderNameToEnvKey(provider.Name)) ||
+			strings.EqualFold(profile.Provider, provider.Name) ||
+			profile.Provider == provider.EnvKey {
+			return provider, nil
+		}
+	}
+	return config.Provider{}, fmt.Errorf("profile %s: unknown provider %q", profile.Name, profile.Provider)
+}
+
+// LoadSettings reads the TUI config file and checks the tests its profiles
+// name against src/tests. The check is skipped when src/tests cannot be read,
+// which the run reports itself.
+func LoadSettings() (config.Settings, error) {
+	settings, err := config.LoadSettings()
+	if err != nil {
+		return config.Settings{}, err
+	}
+	if tests, err := bridge.GetAvailableTests(); err == nil {
+		if err := settings.CheckTests(tests); err != nil {
+			return config.Settings{}, fmt.Errorf("%s: %w", settings.Path, err)
+		}
+	}
+	return settings, nil
+}
+
+// StartProfile applies profile to state and returns the first screen it
+// leaves open: the API key prompt when the provider has no key, then the
+// usual selection screens for whatever the profile leaves empty. A profile
+// with a provider, mode and models starts the run immediately.
+func StartProfile(state *SharedState, profile config.Profile) (tea.Model, tea.Cmd, error) {
+	if state.Config == nil {
+		state.Config = &config.Config{APIKeys: make(map[string]string)}
+	}
+	state.Profile = &profile
+	if profile.Provider == "" {
+		model := NewProviderModelSelectModel(state)
+		return model, model.Init(), nil
+	}
+
+	provider, err := profileProvider(state.Config, profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	state.Provider = bridge.ConvertProviderNameToEnvKey(provider.Name)
+	state.ProviderKey = provider.EnvKey
+	if provider.APIKey == "" {
+		return NewAPIKeyPromptModel(state, provider), nil, nil
+	}
+	model, cmd := continueWithProvider(state)
+	return model, cmd, nil
+}
+
+// continueWithProvider moves on once state has a provider with a key: to the
+// execution mode, unless the active profile sets it.
+func continueWithProvider(state *SharedState) (tea.Model, tea.Cmd) {
+	if state.Profile == nil || state.Profile.Mode == "" {
+		return NewExecutionModeModel(state), nil
+	}
+	state.Parallel = state.Profile.Mode == "parallel"
+	state.Madmax = state.Profile.Mode == "madmax"
+	return continueWithMode(state)
+}
+
+// continueWithMode moves on once the execution mode is chosen: to model
+// selection, or straight to the run when the active profile lists the models
+// for this provider.
+func continueWithMode(state *SharedState) (tea.Model, tea.Cmd) {
+	if models := state.profileModels(); len(models) > 0 {
+		state.Model = strings.Join(models, ",")
+		model := NewBenchmarkModel(state)
+		return model, model.Init()
+	}
+	model := NewModelSelectionModel(state)
+	return model, model.loadModels(model.providers[model.selectedProvider])
+}
+
+// profileModels returns the active profile's models when they belong to the
+// selected provider.
+func (s *SharedState) profileModels() []string {
+	profile := s.Profile
+	if profile == nil || len(profile.Models) == 0 {
+		return nil
+	}
+	if profile.Provider != "" {
+		provider, err := profileProvider(s.Config, *profile)
+		if err != nil || provider.EnvKey != s.ProviderKey {
+			return nil
+		}
+	}
+	return profile.Models
+}
+
+// benchmarkConfig returns the runner configuration for the selected provider
+// and models, with the active profile's samples, tests, context and retry
+// settings.
+func (s *SharedState) benchmarkConfig() bridge.BenchmarkConfig {
+	apiKeys := make(map[string]string)
+	if s.Config != nil {
+		for key, value := range s.Config.APIKeys {
+			apiKeys[key] = value
+		}
+	}
+	benchmark := bridge.BenchmarkConfig{
+		Provider: s.Provider,
+		Model:    s.Model,
+		APIKeys:  apiKeys,
+		Parallel: s.Parallel,
+		Madmax:   s.Madmax,
+		Samples:  defaultSamples,
+	}
+	if profile := s.Profile; profile != nil {
+		if profile.Samples > 0 {
+			benchmark.Samples = profile.Samples
+		}
+		benchmark.Tests = profile.Tests
+		benchmark.ContextFile = profile.Context
+		benchmark.Retry = profile.Retry
+	}
+	return benchmark
+}
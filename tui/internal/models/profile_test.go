@@ -0,0 +1,112 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"svelte-bench/tui/internal/config"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestWelcomeStartsTheDefaultProfile(t *testing.T) {
+	settings := config.Settings{
+		DefaultProfile: "nightly",
+		Profiles: []config.Profile{
+			{Name: "adhoc", Mode: "sequential"},
+			{
+				Name:     "nightly",
+				Provider: "openai",
+				Models:   []string{"gpt-5", "gpt-5-mini"},
+				Mode:     "madmax",
+				Samples:  3,
+				Tests:    []string{"counter", "effect"},
+				Context:  "context/llms-small.txt",
+				Retry:    config.RetrySettings{MaxAttempts: 8},
+			},
+		},
+	}
+	cfg := &config.Config{APIKeys: map[string]string{"OPENAI_API_KEY": "sk-test"}}
+	welcome := NewWelcomeModelWithSettings(cfg, settings)
+
+	view := ansi.Strip(welcome.View().Content)
+	if !strings.Contains(view, "> nightly  openai • 2 models • madmax • 3 samples • 2 tests • with context") {
+		t.Fatalf("expected the default profile focused with its summary:\n%s", view)
+	}
+
+	updated, _ := welcome.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	benchmark, ok := updated.(BenchmarkModel)
+	if !ok {
+		t.Fatalf("a complete profile should start the run, got %T", updated)
+	}
+	if benchmark.state.Model != "gpt-5,gpt-5-mini" || !benchmark.state.Madmax || benchmark.state.Parallel {
+		t.Fatalf("expected the profile's models and mode, got %+v", benchmark.state)
+	}
+	if strings.Join(benchmark.testOrder, ",") != "counter,effect" || benchmark.totalSamples != 2*2*3 {
+		t.Fatalf("expected 2 tests of 3 samples per model, got %v and %d samples", benchmark.testOrder, benchmark.totalSamples)
+	}
+
+	run := benchmark.state.benchmarkConfig()
+	if run.Samples != 3 || run.ContextFile != "context/llms-small.txt" || run.Retry.MaxAttempts != 8 || len(run.Tests) != 2 {
+		t.Fatalf("expected the profile's run settings, got %+v", run)
+	}
+}
+
+func TestProfileLeavesUnsetChoicesToTheUser(t *testing.T) {
+	cfg := &config.Config{APIKeys: map[string]string{"OPENAI_API_KEY": "sk-test"}}
+
+	model, _, err := StartProfile(&SharedState{Config: cfg}, config.Profile{Name: "pick", Provider: "OpenAI"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := model.(ExecutionModeModel); !ok {
+		t.Fatalf("a profile without a mode should ask for one, got %T", model)
+	}
+
+	model, _, err = StartProfile(&SharedState{Config: cfg}, config.Profile{Name: "keyless", Provider: "anthropic", Mode: "parallel"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := model.(APIKeyPromptModel); !ok {
+		t.Fatalf("a provider without a key should prompt for it, got %T", model)
+	}
+
+	if _, _, err := StartProfile(&SharedState{Config: cfg}, config.Profile{Name: "typo", Provider: "opnai"}); err == nil {
+		t.Fatal("expected an unknown provider to be an error")
+	}
+
+	manual := NewWelcomeModelWithSettings(cfg, config.Settings{Profiles: []config.Profile{{Name: "nightly"}}})
+	updated, _ := manual.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if _, ok := updated.(ProviderModelSelectModel); !ok {
+		t.Fatalf("manual setup should open provider selection, got %T", updated)
+	}
+}
+
+func TestLoadSettingsChecksProfileTests(t *testing.T) {
+	root := filepath.Dir(benchmarkProject(t))
+	if err := os.MkdirAll(filepath.Join(root, "src", "tests", "counter"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, "config.toml")
+	t.Setenv(config.ConfigFileEnv, path)
+
+	if err := os.WriteFile(path, []byte("[profiles.nightly]\ntests = [\"counter\"]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if settings, err := LoadSettings(); err != nil || len(settings.Profiles) != 1 {
+		t.Fatalf("expected the profile to load, got %+v, %v", settings, err)
+	}
+
+	if err := os.WriteFile(path, []byte("[profiles.nightly]\ntests = [\"countr\"]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSettings(); err == nil || !strings.Contains(err.Error(), `profile nightly: unknown test "countr"`) {
+		t.Fatalf("expected the typo reported on load, got %v", err)
+	}
+	if welcome := NewWelcomeModel(nil); !strings.Contains(welcome.error, `unknown test "countr"`) {
+		t.Fatalf("expected the welcome screen to show the error, got %q", welcome.error)
+	}
+}
@@ -149,6 +149,18 @@ func (m ProviderModelSelectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, tea.Quit
 				}
 				return NewExecutionModeModel(m.state), nil
+			case "h":
+				model := NewHistoryModel(m.state)
+				return model, model.Init()
+			case "l":
+				model := NewLeaderboardModel(m.state, nil)
+				return model, model.Init()
+			case "t":
+				model := NewTrendsModel(m.state, "", nil)
+				return model, model.Init()
+			case "d":
+				model := NewDifficultyModel(m.state, nil)
+				return model, model.Init()
 			case "up":
 				if m.selectedProvider == 0 && len(m.providers) > 0 && len(m.providers) < wrapNavigationLimit {
 					m.selectedProvider = len(m.providers) - 1
@@ -192,7 +204,7 @@ func (m ProviderModelSelectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				// Keep the flow consistent whether the key was already configured or
 				// entered moments ago: choose execution mode before loading models.
-				return NewExecutionModeModel(m.state), nil
+				return continueWithProvider(m.state)
 			}
 		} else {
 			// Step 1: Type model name with autocomplete
@@ -317,6 +329,7 @@ func (m ProviderModelSelectModel) View() tea.View {
 	if m.step == 0 {
 		title := styles.HeadingStyle.Render("SELECT PROVIDER")
 		lines = append(lines, styles.SectionLabelStyle.Render("01 / PROVIDER"), title, "")
+		lines = append(lines, renderEnvWarnings(m.state.Config, m.width)...)
 	} else {
 		title := styles.HeadingStyle.Render("SELECT MODELS")
 		lines = append(lines, styles.SectionLabelStyle.Render("03 / MODELS"), title, "")
@@ -371,7 +384,7 @@ func (m ProviderModelSelectModel) View() tea.View {
 		lines = append(lines, "")
 		lines = append(lines, lipgloss.NewStyle().
 			Foreground(styles.GrayDim).
-			Render("Up/Down: Navigate • Enter: Select • ✓ Valid • Stored • ! Invalid • Left: Back • Double Esc: Quit • Ctrl+C: Quit"))
+			Render("Up/Down: Navigate • Enter: Select • ✓ Valid • Stored • ! Invalid • H: History • L: Leaderboard • T: Trends • D: Test difficulty • Left: Back • Double Esc: Quit • Ctrl+C: Quit"))
 	} else {
 		// Searchable, multi-select model catalog.
 		providerName := m.providers[m.selectedProvider].Name
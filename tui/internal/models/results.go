@@ -1,10 +1,18 @@
 package models
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
 	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/components"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/stats"
 	"svelte-bench/tui/internal/styles"
 
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 )
@@ -15,26 +23,126 @@ type ResultsModel struct {
 	selectedOption int
 	openingResults bool
 	openError      string
-	width          int
-	height         int
+	// openFallback holds the file no opener could open, to offer its
+	// file:// URL and serve it locally instead.
+	openFallback   string
+	serveURL       string
+	serveError     string
+	junitStatus    string
+	junitError     string
+	baselineChecks []analysis.Regression
+	// baselineSuiteWarning is set when the baseline was scored against
+	// different tests.
+	baselineSuiteWarning string
+	modelScores          []analysis.ModelScore
+	baselineStatus       string
+	baselineError        string
+	margin               float64
+	entries              []results.Entry
+	entriesError         string
+	disagreements        []string
+	openedFiles          string
+	matrix               analysis.Leaderboard
+	showMatrix           bool
+	tests                components.Table[TestResult]
+	matrixTable          components.Table[analysis.LeaderboardRow]
+	passK                []int
+	kInput               textinput.Model
+	editingK             bool
+	kError               string
+	width                int
+	height               int
+	run                  *bridge.BenchmarkRun
+	history              *HistoryModel
+	// averageInterval is the bootstrap interval of the average pass@1,
+	// computed when the results change rather than on every render.
+	averageInterval stats.Interval
+	// terminal is the benchmark screen's terminal integration, whose
+	// progress and window title the results clear.
+	terminal terminalIntegration
 }
 
+// matrixCellWidth fits a pass@1/pass@10 cell such as "100/100".
+const matrixCellWidth = 7
+
+// matrixOverallColumn is the matrix column of each model's overall score.
+const matrixOverallColumn = 1
+
+// resultsOptions are the actions offered below the results table.
+var resultsOptions = []string{"View benchmarks", "Open run JSON", "Export results", "Run another benchmark", "Exit"}
+
 type resultsOpenedMsg struct {
 	err error
 }
 
+// errNoRunFiles is shown by actions that need the results files of the run.
+var errNoRunFiles = errors.New("no results file was saved for this run")
+
+type reportServedMsg struct {
+	url string
+	err error
+}
+
 // NewResultsModel creates a new results model
 func NewResultsModel(state *SharedState) ResultsModel {
 	return ResultsModel{
 		state:          state,
 		selectedOption: 0,
-		width:          80,
-		height:         24,
+		margin:         regressionMargin(),
+		passK:          passAtKValues(state),
+		kInput:         newKInput(),
+		tests:          components.NewTable(nil, testStatus),
+		matrixTable: components.NewTable(nil, func(row analysis.LeaderboardRow) components.RowStatus {
+			return scoreStatus(row.Overall)
+		}),
+		averageInterval: averageInterval(state.Results),
+		width:           80,
+		height:          24,
 	}
 }
 
+// NewStoredResultsModel shows a run loaded from the benchmarks directory in the
+// same view used after a live run. Going back returns to the history browser.
+func NewStoredResultsModel(state *SharedState, run bridge.BenchmarkRun, history *HistoryModel) ResultsModel {
+	stored := &SharedState{
+		Config:                   state.Config,
+		ValidatedProviders:       state.ValidatedProviders,
+		ProviderValidationErrors: state.ProviderValidationErrors,
+		Provider:                 run.Provider,
+		Model:                    strings.Join(run.Models, ","),
+		Results:                  storedTestResults(run.Results),
+		Completed:                true,
+		PassAtK:                  state.PassAtK,
+	}
+	m := NewResultsModel(stored)
+	m.run = &run
+	m.history = history
+	if history != nil {
+		m.width = history.width
+		m.height = history.height
+	}
+	return m
+}
+
 func (m ResultsModel) Init() tea.Cmd {
-	return nil
+	side, ok := m.compareSide()
+	if !ok {
+		return nil
+	}
+	return tea.Batch(loadRunEntriesCmd(side.paths), checkBaselineCmd(side.paths, m.margin))
+}
+
+type runEntriesLoadedMsg struct {
+	entries []results.Entry
+	err     error
+}
+
+// loadRunEntriesCmd reads the per-model entries of the displayed run.
+func loadRunEntriesCmd(paths []string) tea.Cmd {
+	return func() tea.Msg {
+		entries, err := loadEntries(paths)
+		return runEntriesLoadedMsg{entries: entries, err: err}
+	}
 }
 
 func (m ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -48,6 +156,19 @@ func (m ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.openingResults {
 			return m, nil
 		}
+		if m.editingK {
+			return m.updateKInput(msg)
+		}
+		m.syncTables()
+		if m.showMatrix {
+			if table, handled := m.matrixTable.Update(msg); handled {
+				m.matrixTable = table
+				return m, nil
+			}
+		} else if table, handled := m.tests.Update(msg); handled {
+			m.tests = table
+			return m, nil
+		}
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -56,16 +177,92 @@ func (m ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			}
 		case "left":
+			if m.history != nil {
+				return *m.history, nil
+			}
 			model := NewProviderModelSelectModel(m.state)
 			return model, model.Init()
 
+		case "h":
+			model := NewHistoryModel(m.state)
+			return model, model.Init()
+
+		case "l":
+			model := NewLeaderboardModel(m.state, m)
+			return model, model.Init()
+
+		case "s":
+			side, ok := m.compareSide()
+			if !ok {
+				m.setOpenError(errNoRunFiles)
+				return m, nil
+			}
+			model := NewSamplesModel(m.state, side.paths, m)
+			return model, model.Init()
+
+		case "w":
+			if m.openFallback != "" {
+				return m, serveReportCmd(m.openFallback)
+			}
+		case "f":
+			side, ok := m.compareSide()
+			if !ok {
+				m.setOpenError(errNoRunFiles)
+				return m, nil
+			}
+			model := NewFailuresModel(m.state, side.paths, m)
+			model.width, model.height = m.width, m.height
+			return model, model.Init()
+
+		case "e":
+			return m.openExport()
+
+		case "j":
+			return m.openRunFiles()
+
+		case "m":
+			if len(m.matrix.Rows) > 0 {
+				m.showMatrix = !m.showMatrix
+			}
+
+		case "o":
+			if m.matrixTable.SortColumn() == matrixOverallColumn {
+				m.matrixTable.SortBy(-1, false)
+			} else {
+				m.matrixTable.SortBy(matrixOverallColumn, true)
+			}
+
+		case "k":
+			m.editingK = true
+			m.kError = ""
+			m.kInput.SetValue(analysis.FormatKValues(m.passK))
+			m.kInput.CursorEnd()
+			return m, m.kInput.Focus()
+
+		case "b":
+			side, ok := m.compareSide()
+			if !ok {
+				m.setOpenError(errNoRunFiles)
+				return m, nil
+			}
+			return m, markBaselineCmd(side.paths)
+
+		case "c":
+			side, ok := m.compareSide()
+			if !ok {
+				m.setOpenError(errNoRunFiles)
+				return m, nil
+			}
+			model := NewComparePickerModel(m.state, side, m)
+			return model, model.Init()
+
 		case "up":
 			if m.selectedOption > 0 {
 				m.selectedOption--
 			}
 
 		case "down":
-			if m.selectedOption < 2 {
+			if m.selectedOption < len(resultsOptions)-1 {
 				m.selectedOption++
 			}
 
@@ -73,37 +270,219 @@ func (m ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch m.selectedOption {
 			case 0:
 				m.openingResults = true
-				m.openError = ""
+				m.setOpenError(nil)
 				return m, m.openResults()
 			case 1:
+				return m.openRunFiles()
+			case 2:
+				return m.openExport()
+			case 3:
 				// Run another benchmark
 				model := NewProviderModelSelectModel(m.state)
 				return model, model.Init()
-			case 2:
+			case 4:
 				// Exit
 				return m, tea.Quit
 			}
 		}
 
+	case runEntriesLoadedMsg:
+		if msg.err != nil {
+			m.entriesError = msg.err.Error()
+			return m, nil
+		}
+		if m.run == nil && len(msg.entries) > 0 {
+			// The saved files are authoritative; the streamed counters are
+			// only kept to check that nothing was lost on the way.
+			saved := storedTestResults(msg.entries)
+			m.disagreements = resultsDisagreements(m.state.Results, saved)
+			m.state.Results = saved
+			m.averageInterval = averageInterval(saved)
+		}
+		m.entries = msg.entries
+		m.modelScores = analysis.ScoreModels(msg.entries)
+		m.matrix = analysis.BuildLeaderboard(msg.entries, analysis.LeaderboardLatest)
+		m.matrix.Sort(analysis.SortByModel, "")
+		// One averaged row per test hides which model failed it.
+		m.showMatrix = len(m.matrix.Rows) > 1
+		return m, nil
+
+	case baselineCheckedMsg:
+		if msg.err != nil {
+			m.baselineError = msg.err.Error()
+			return m, nil
+		}
+		m.baselineChecks = msg.checks
+		m.baselineSuiteWarning = msg.suiteWarning
+		return m, nil
+
+	case baselineMarkedMsg:
+		if msg.err != nil {
+			m.baselineError = msg.err.Error()
+			return m, nil
+		}
+		m.baselineError = ""
+		m.baselineChecks = nil
+		m.baselineSuiteWarning = ""
+		m.baselineStatus = "Baseline set for " + strings.Join(msg.models, ", ")
+		return m, nil
+
+	case runFilesOpenedMsg:
+		m.setOpenError(msg.err)
+		if msg.err != nil {
+			return m, nil
+		}
+		m.openedFiles = fmt.Sprintf("Opened %d results file(s)", len(msg.paths))
+		return m, nil
+
+	case junitWrittenMsg:
+		if msg.err != nil {
+			m.junitError = msg.err.Error()
+		} else {
+			m.junitStatus = "JUnit report written to " + msg.path
+		}
+		return m, nil
+
 	case resultsOpenedMsg:
 		m.openingResults = false
+		m.setOpenError(msg.err)
 		if msg.err != nil {
-			m.openError = msg.err.Error()
 			return m, nil
 		}
 		return NewWelcomeModel(m.state.Config), nil
+
+	case reportServedMsg:
+		m.serveURL, m.serveError = msg.url, ""
+		if msg.err != nil {
+			m.serveError = msg.err.Error()
+		}
+		return m, nil
 	}
 
 	return m, nil
 }
 
+// updateKInput edits the pass@k values shown in the results table.
+func (m ResultsModel) updateKInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.editingK = false
+		m.kError = ""
+		m.kInput.Blur()
+		return m, nil
+	case "enter":
+		ks, err := analysis.ParseKValues(m.kInput.Value())
+		if err != nil {
+			m.kError = err.Error()
+			return m, nil
+		}
+		m.passK = ks
+		m.state.PassAtK = ks
+		m.editingK = false
+		m.kError = ""
+		m.kInput.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.kInput, cmd = m.kInput.Update(msg)
+	return m, cmd
+}
+
+// passAtK returns pass@k of a test. Once the run's files are loaded it is
+// computed from the stored sample outcomes; before that only the pass@1 and
+// pass@10 reported by the runner are known.
+func (m ResultsModel) passAtK(result TestResult, k int) (float64, bool) {
+	if m.entries != nil {
+		return analysis.TestPassAtK(m.entries, result.TestName, k)
+	}
+	switch {
+	case k == 1:
+		return result.PassAtOne, true
+	case k == 10 && result.Current >= 10:
+		return result.PassAtTen, true
+	}
+	return 0, false
+}
+
+// extraKValues are the selected k values shown next to pass@1, which every
+// row already shows with its interval.
+func (m ResultsModel) extraKValues() []int {
+	ks := make([]int, 0, len(m.passK))
+	for _, k := range m.passK {
+		if k != 1 {
+			ks = append(ks, k)
+		}
+	}
+	return ks
+}
+
+// renderAveragePassAtK averages the extra pass@k values over the tests that
+// ran enough samples for them.
+func (m ResultsModel) renderAveragePassAtK() []string {
+	var parts []string
+	for _, k := range m.extraKValues() {
+		total, count := 0.0, 0
+		for _, result := range m.state.Results {
+			if score, ok := m.passAtK(result, k); ok {
+				total += score
+				count++
+			}
+		}
+		if count == 0 {
+			parts = append(parts, fmt.Sprintf("pass@%d: —", k))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("pass@%d: %.0f%%", k, total/float64(count)*100))
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	return []string{lipgloss.NewStyle().
+		Foreground(styles.GrayMedium).
+		Render("Average " + strings.Join(parts, " • "))}
+}
+
 func (m ResultsModel) View() tea.View {
+	m.syncTables()
+
+	lines := m.renderHeader()
+	if m.showMatrix {
+		lines = append(lines, m.matrixTable.View())
+	} else {
+		lines = append(lines, m.tests.View())
+	}
+	lines = append(lines, m.renderFooter()...)
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	view := newView(content)
+	m.terminal.clear(&view)
+	return view
+}
+
+// renderHeader renders the title and run summary above the results table.
+func (m ResultsModel) renderHeader() []string {
 	var lines []string
 
 	// Title
 	title := styles.HeadingStyle.Render("BENCHMARK COMPLETE")
+	if m.run != nil {
+		title = styles.HeadingStyle.Render("STORED RUN")
+	}
 
-	lines = append(lines, title, "")
+	lines = append(lines, title)
+	if m.run != nil {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(fmt.Sprintf("%s • %s", m.run.Date.Local().Format("2006-01-02 15:04"), m.run.Name())))
+	}
+	lines = append(lines, "")
 
 	// Summary
 	totalTests := len(m.state.Results)
@@ -130,119 +509,502 @@ func (m ResultsModel) View() tea.View {
 		passColor = styles.OrangeWarning
 	}
 
-	stats := lipgloss.NewStyle().
+	average := lipgloss.NewStyle().
 		Foreground(passColor).
 		Bold(true).
-		Render(fmt.Sprintf("Average pass@1: %.0f%% (%d/%d tests passed)", avgPass*100, totalPassed, totalTests))
+		Render(fmt.Sprintf("Average pass@1: %.0f%% (%d/%d tests passed) • 95%% CI %s",
+			avgPass*100, totalPassed, totalTests, formatInterval(m.averageInterval)))
 
-	lines = append(lines, summary, stats, "", "")
+	lines = append(lines, summary, average)
+	lines = append(lines, m.renderAveragePassAtK()...)
+	lines = append(lines, m.renderFileWarning()...)
+	lines = append(lines, m.renderModelScores()...)
+	lines = append(lines, "", "")
 
 	// Results table
 	resultsHeader := lipgloss.NewStyle().
 		Foreground(styles.OrangeMid).
 		Render("Results:")
-	lines = append(lines, resultsHeader)
+	return append(lines, resultsHeader)
+}
 
-	maxResults := m.height - 14
-	if maxResults < 3 {
-		maxResults = 3
+// averageInterval bootstraps the interval of the average pass@1 over the
+// tests' sample outcomes.
+func averageInterval(tests []TestResult) stats.Interval {
+	outcomes := make([]stats.Proportion, 0, len(tests))
+	for _, result := range tests {
+		outcomes = append(outcomes, stats.Proportion{Correct: result.Correct, Total: result.Current})
 	}
-	if maxResults > len(m.state.Results) {
-		maxResults = len(m.state.Results)
+	return stats.BootstrapMean(outcomes)
+}
+
+// resultsHelp lists the results screen's keys, the table's first, in lines
+// short enough for an 80-column terminal.
+var resultsHelp = []string{
+	"Tab: Sort • Shift+Tab: Reverse • V: Filter • PgUp/PgDn: Scroll",
+	"M: Matrix • O: Sort models • K: pass@k • B: Set baseline • C: Compare",
+	"H: History • L: Leaderboard • S: Samples • F: Failures • J: JSON • E: Export",
+	"Up/Down: Navigate • Enter: Select • Left: Back • Double Esc/Q/Ctrl+C: Quit",
+}
+
+// renderFooter renders the baseline comparison, actions and help below the
+// results table.
+func (m ResultsModel) renderFooter() []string {
+	var lines []string
+	if m.showMatrix {
+		lines = append(lines, m.renderMatrixNote())
 	}
+	lines = append(lines, m.renderBaseline()...)
+
+	lines = append(lines, "", "")
 
-	for i := 0; i < maxResults; i++ {
-		result := m.state.Results[i]
+	// Options
+	for i, option := range resultsOptions {
+		if i == m.selectedOption {
+			lines = append(lines, lipgloss.NewStyle().
+				Foreground(styles.OrangePrimary).
+				Bold(true).
+				Render("> "+option))
+		} else {
+			lines = append(lines, "  "+option)
+		}
+	}
+	if m.openingResults {
+		lines = append(lines, "", styles.ProgressTextStyle.Render("Opening all results..."))
+	} else if m.openError != "" {
+		lines = append(lines, "", styles.ErrorStyle.Render("Could not open results: "+m.openError))
+		lines = append(lines, m.renderOpenFallback()...)
+	} else if m.openedFiles != "" {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(styles.GrayMedium).Render(m.openedFiles))
+	}
+	if m.junitError != "" {
+		lines = append(lines, styles.ErrorStyle.Render("Could not write JUnit report: "+m.junitError))
+	} else if m.junitStatus != "" {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render(m.junitStatus))
+	}
 
-		// Icon
-		icon := "[OK]"
-		iconColor := styles.OrangeSuccess
-		if !result.Passed || result.PassAtOne < 0.5 {
-			icon = "[FAIL]"
-			iconColor = styles.OrangeError
-		} else if result.PassAtOne < 0.7 {
-			icon = "!"
-			iconColor = styles.OrangeWarning
+	// Help
+	lines = append(lines, "")
+	if m.editingK {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("PASS@K  ")+m.kInput.View())
+		if m.kError != "" {
+			lines = append(lines, styles.ErrorStyle.Render(m.kError))
+		}
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render("Comma-separated k values • Enter: Apply • Esc: Cancel"))
+	} else {
+		help := lipgloss.NewStyle().Foreground(styles.GrayDim)
+		for _, keys := range resultsHelp {
+			lines = append(lines, help.Render(keys))
 		}
+	}
+	return lines
+}
+
+// testStatus classifies a test row the way its status icon does.
+func testStatus(result TestResult) components.RowStatus {
+	switch {
+	case !result.Passed || result.PassAtOne < 0.5:
+		return components.RowFailing
+	case result.PassAtOne < 0.7:
+		return components.RowWarning
+	}
+	return components.RowPassing
+}
 
-		iconStyled := lipgloss.NewStyle().
-			Foreground(iconColor).
-			Bold(true).
-			Render(icon)
+// scoreStatus classifies a score with the thresholds of scoreColor.
+func scoreStatus(score float64) components.RowStatus {
+	switch {
+	case score < 0.5:
+		return components.RowFailing
+	case score < 0.7:
+		return components.RowWarning
+	}
+	return components.RowPassing
+}
 
-		// Test name
-		nameStyle := lipgloss.NewStyle().
-			Width(15).
-			Foreground(styles.GrayMedium)
-		name := nameStyle.Render(result.TestName)
+// renderScore renders a percentage in the color of its score.
+func renderScore(score float64) string {
+	return lipgloss.NewStyle().Foreground(scoreColor(score)).Render(fmt.Sprintf("%.0f%%", score*100))
+}
 
-		// Pass@1
-		passColor := styles.OrangeSuccess
-		if result.PassAtOne < 0.5 {
-			passColor = styles.OrangeError
-		} else if result.PassAtOne < 0.7 {
-			passColor = styles.OrangeWarning
-		}
+var missingCell = lipgloss.NewStyle().Foreground(styles.GrayDim).Render("—")
 
-		pass1 := lipgloss.NewStyle().
-			Foreground(passColor).
-			Render(fmt.Sprintf("%.0f%%", result.PassAtOne*100))
+// lessPresent orders missing values before present ones, so sorting a column
+// largest first puts them last.
+func lessPresent(a float64, aOK bool, b float64, bOK bool) bool {
+	if aOK != bOK {
+		return !aOK
+	}
+	return a < b
+}
 
-		lines = append(lines, fmt.Sprintf(" %s %s  %s", iconStyled, name, pass1))
+// testColumns are the per-test table columns: status, name, pass@1 with its
+// interval, the extra pass@k values and, once compared with a baseline, the
+// change in pass@1.
+func (m ResultsModel) testColumns() []components.TableColumn[TestResult] {
+	columns := []components.TableColumn[TestResult]{
+		{
+			Title: "",
+			Width: 6,
+			Render: func(result TestResult) string {
+				switch testStatus(result) {
+				case components.RowFailing:
+					return lipgloss.NewStyle().Foreground(styles.OrangeError).Bold(true).Render("[FAIL]")
+				case components.RowWarning:
+					return lipgloss.NewStyle().Foreground(styles.OrangeWarning).Bold(true).Render("!")
+				}
+				return lipgloss.NewStyle().Foreground(styles.OrangeSuccess).Bold(true).Render("[OK]")
+			},
+		},
+		{
+			Title: "TEST",
+			Width: 15,
+			Render: func(result TestResult) string {
+				return lipgloss.NewStyle().Foreground(styles.GrayMedium).Render(result.TestName)
+			},
+			Less: func(a, b TestResult) bool { return a.TestName < b.TestName },
+		},
+		{
+			Title:          "PASS@1",
+			Width:          6,
+			Align:          lipgloss.Right,
+			Render:         func(result TestResult) string { return renderScore(result.PassAtOne) },
+			Less:           func(a, b TestResult) bool { return a.PassAtOne < b.PassAtOne },
+			SortDescending: true,
+		},
+		{
+			Title: "95% CI",
+			Width: 10,
+			Render: func(result TestResult) string {
+				return lipgloss.NewStyle().
+					Foreground(styles.GrayDim).
+					Render(formatInterval(stats.Wilson(result.Correct, result.Current, stats.Z95)))
+			},
+		},
 	}
 
-	if maxResults < len(m.state.Results) {
-		remaining := len(m.state.Results) - maxResults
-		lines = append(lines, lipgloss.NewStyle().
-			Foreground(styles.GrayDim).
-			Render(fmt.Sprintf("  ... and %d more", remaining)))
+	for _, k := range m.extraKValues() {
+		columns = append(columns, components.TableColumn[TestResult]{
+			Title: fmt.Sprintf("PASS@%d", k),
+			Width: 7,
+			Align: lipgloss.Right,
+			Render: func(result TestResult) string {
+				if score, ok := m.passAtK(result, k); ok {
+					return renderScore(score)
+				}
+				return missingCell
+			},
+			Less: func(a, b TestResult) bool {
+				aScore, aOK := m.passAtK(a, k)
+				bScore, bOK := m.passAtK(b, k)
+				return lessPresent(aScore, aOK, bScore, bOK)
+			},
+			SortDescending: true,
+		})
 	}
 
-	lines = append(lines, "", "")
+	if deltas := m.baselineDeltas(); len(deltas) > 0 {
+		columns = append(columns, components.TableColumn[TestResult]{
+			Title: "DELTA",
+			Width: 6,
+			Align: lipgloss.Right,
+			Render: func(result TestResult) string {
+				delta, ok := deltas[result.TestName]
+				if !ok {
+					return missingCell
+				}
+				color := styles.GrayMedium
+				if delta < 0 {
+					color = styles.OrangeError
+				} else if delta > 0 {
+					color = styles.OrangeSuccess
+				}
+				return lipgloss.NewStyle().Foreground(color).Render(fmt.Sprintf("%+.0f%%", delta*100))
+			},
+			// Ascending by default, so the largest drops come first.
+			Less: func(a, b TestResult) bool {
+				aDelta, aOK := deltas[a.TestName]
+				bDelta, bOK := deltas[b.TestName]
+				if aOK != bOK {
+					return aOK
+				}
+				return aDelta < bDelta
+			},
+		})
+	}
+	return columns
+}
 
-	// Options
-	opt1 := "  View benchmarks"
-	opt2 := "  Run another benchmark"
-	opt3 := "  Exit"
-
-	if m.selectedOption == 0 {
-		opt1 = lipgloss.NewStyle().
-			Foreground(styles.OrangePrimary).
-			Bold(true).
-			Render("> View benchmarks")
-	} else if m.selectedOption == 1 {
-		opt2 = lipgloss.NewStyle().
-			Foreground(styles.OrangePrimary).
-			Bold(true).
-			Render("> Run another benchmark")
-	} else if m.selectedOption == 2 {
-		opt3 = lipgloss.NewStyle().
-			Foreground(styles.OrangePrimary).
-			Bold(true).
-			Render("> Exit")
-	}
-
-	lines = append(lines, opt1, opt2, opt3)
-	if m.openingResults {
-		lines = append(lines, "", styles.ProgressTextStyle.Render("Opening all results..."))
-	} else if m.openError != "" {
-		lines = append(lines, "", styles.ErrorStyle.Render("Could not open results: "+m.openError))
+// baselineDeltas averages the change in pass@1 against the baseline over the
+// models of each test.
+func (m ResultsModel) baselineDeltas() map[string]float64 {
+	totals := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, check := range m.baselineChecks {
+		if !check.Baseline.Present || !check.Candidate.Present {
+			continue
+		}
+		totals[check.TestName] += check.Delta
+		counts[check.TestName]++
+	}
+	for test, count := range counts {
+		totals[test] /= float64(count)
+	}
+	return totals
+}
+
+// matrixColumns are the columns of the model × test matrix: the model, its
+// overall score and pass@1/pass@10 on every test.
+func (m ResultsModel) matrixColumns() []components.TableColumn[analysis.LeaderboardRow] {
+	nameWidth := min(max(14, m.width-8-10-len(m.matrix.Tests)*(matrixCellWidth+1)), 28)
+	columns := []components.TableColumn[analysis.LeaderboardRow]{
+		{
+			Title: "MODEL",
+			Width: nameWidth,
+			Render: func(row analysis.LeaderboardRow) string {
+				return lipgloss.NewStyle().Foreground(styles.GrayLight).Render(row.ModelID)
+			},
+			Less: func(a, b analysis.LeaderboardRow) bool { return a.ModelID < b.ModelID },
+		},
+		{
+			Title: "OVERALL",
+			Width: 8,
+			Align: lipgloss.Right,
+			Render: func(row analysis.LeaderboardRow) string {
+				return lipgloss.NewStyle().Foreground(scoreColor(row.Overall)).Bold(true).Render(fmt.Sprintf("%.0f%%", row.Overall*100))
+			},
+			Less:           func(a, b analysis.LeaderboardRow) bool { return a.Overall < b.Overall },
+			SortDescending: true,
+		},
 	}
+	for _, test := range m.matrix.Tests {
+		columns = append(columns, components.TableColumn[analysis.LeaderboardRow]{
+			Title: strings.ToUpper(test),
+			Width: matrixCellWidth,
+			Align: lipgloss.Right,
+			Render: func(row analysis.LeaderboardRow) string {
+				cell, ok := row.Cells[test]
+				if !ok {
+					return lipgloss.NewStyle().Foreground(styles.GrayDim).Render("--")
+				}
+				return lipgloss.NewStyle().
+					Foreground(scoreColor(cell.PassAtOne)).
+					Render(fmt.Sprintf("%.0f/%.0f", cell.PassAtOne*100, cell.PassAtTen*100))
+			},
+			Less: func(a, b analysis.LeaderboardRow) bool {
+				aCell, aOK := a.Cells[test]
+				bCell, bOK := b.Cells[test]
+				return lessPresent(aCell.PassAtOne, aOK, bCell.PassAtOne, bOK)
+			},
+			SortDescending: true,
+		})
+	}
+	return columns
+}
 
-	// Help
-	lines = append(lines, "")
-	help := lipgloss.NewStyle().
+// syncTables refreshes the tables from the run's results and fits them into
+// the rows left by the rest of the screen, keeping their scroll position,
+// sort order and filter.
+func (m *ResultsModel) syncTables() {
+	m.tests.SetColumns(m.testColumns())
+	m.tests.SetRows(m.state.Results)
+	m.matrixTable.SetColumns(m.matrixColumns())
+	m.matrixTable.SetRows(m.matrix.Rows)
+
+	// Padding, the table header and its status line.
+	used := 4 + 2 + len(m.renderHeader()) + len(m.renderFooter())
+	m.tests.SetHeight(max(3, m.height-used))
+	m.matrixTable.SetHeight(max(3, m.height-used))
+}
+
+// renderMatrixNote explains the matrix cells.
+func (m ResultsModel) renderMatrixNote() string {
+	return lipgloss.NewStyle().
 		Foreground(styles.GrayDim).
-		Render("Up/Down: Navigate • Enter: Select • Left: Back • Double Esc: Quit • Q/Ctrl+C: Quit")
-	lines = append(lines, help)
+		Render("  Cells show pass@1/pass@10 • O: Sort by name/overall")
+}
 
-	content := lipgloss.NewStyle().
-		Padding(2, 2).
-		MaxWidth(m.width).
-		MaxHeight(m.height).
-		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+// renderModelScores ranks the models of a multi-model run, marking models
+// whose overall score is not significantly different from the one above.
+func (m ResultsModel) renderModelScores() []string {
+	if len(m.modelScores) < 2 {
+		return nil
+	}
+	lines := []string{"", styles.SectionLabelStyle.Render("MODELS")}
+	for i, score := range m.modelScores {
+		line := fmt.Sprintf("  %d. %s %s %s", i+1,
+			lipgloss.NewStyle().Width(28).Foreground(styles.GrayLight).Render(truncateText(score.Model, 28)),
+			lipgloss.NewStyle().Width(4).Align(lipgloss.Right).Foreground(scoreColor(score.Overall)).Render(fmt.Sprintf("%.0f%%", score.Overall*100)),
+			lipgloss.NewStyle().Foreground(styles.GrayDim).Render(formatInterval(score.Interval)))
+		if score.TiedWithPrevious {
+			line += lipgloss.NewStyle().
+				Foreground(styles.OrangeWarning).
+				Render("  ≈ not significantly different from " + m.modelScores[i-1].Model)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderBaseline summarizes the comparison with each model's baseline run,
+// listing the tests that regressed.
+func (m ResultsModel) renderBaseline() []string {
+	if m.baselineError != "" {
+		return []string{"", styles.ErrorStyle.Render("Baseline: " + m.baselineError)}
+	}
+	if m.baselineStatus != "" {
+		return []string{"", lipgloss.NewStyle().Foreground(styles.GrayMedium).Render(m.baselineStatus)}
+	}
+	if len(m.baselineChecks) == 0 {
+		return nil
+	}
+
+	regressions := analysis.Regressions(m.baselineChecks)
+	label := styles.SectionLabelStyle.Render("VS BASELINE  ")
+	if len(regressions) == 0 {
+		return append([]string{"", label + lipgloss.NewStyle().
+			Foreground(styles.OrangeSuccess).
+			Render(fmt.Sprintf("No regressions in %d tests (margin %.0f%%)", len(m.baselineChecks), m.margin*100))},
+			renderSuiteWarning(m.baselineSuiteWarning, m.width)...)
+	}
+
+	lines := []string{"", label + lipgloss.NewStyle().
+		Foreground(styles.OrangeError).
+		Bold(true).
+		Render(fmt.Sprintf("%d of %d tests regressed (margin %.0f%%)", len(regressions), len(m.baselineChecks), m.margin*100))}
+	lines = append(lines, renderSuiteWarning(m.baselineSuiteWarning, m.width)...)
+	multipleModels := false
+	for _, check := range regressions {
+		if check.Model != regressions[0].Model {
+			multipleModels = true
+		}
+	}
+	for _, check := range regressions {
+		name := check.TestName
+		if multipleModels {
+			name = check.Model + " " + name
+		}
+		reason := fmt.Sprintf("p=%.3f", check.PValue)
+		if check.Significant {
+			reason += " *"
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s  %s",
+			lipgloss.NewStyle().Foreground(styles.OrangeError).Bold(true).Render("[FAIL]"),
+			lipgloss.NewStyle().Width(15).Foreground(styles.GrayMedium).Render(name),
+			lipgloss.NewStyle().Foreground(styles.OrangeError).Render(fmt.Sprintf("%.0f%% → %.0f%% (%+.0f%%) %s",
+				check.Baseline.PassAtOne()*100, check.Candidate.PassAtOne()*100, check.Delta*100, reason))))
+	}
+	return lines
+}
+
+// compareSide describes the displayed run for a comparison: the stored file
+// or the files the live run just wrote.
+func (m ResultsModel) compareSide() (compareSide, bool) {
+	if m.run != nil {
+		return storedCompareSide(*m.run), true
+	}
+	if len(m.state.ResultFiles) == 0 {
+		return compareSide{}, false
+	}
+	return compareSide{
+		label: "This run • " + modelRunSummary(m.state.Model),
+		date:  m.state.RunStarted,
+		paths: m.state.ResultFiles,
+	}, true
+}
+
+// openRunFiles opens the results files of the displayed run.
+func (m ResultsModel) openRunFiles() (tea.Model, tea.Cmd) {
+	side, ok := m.compareSide()
+	if !ok {
+		m.setOpenError(errNoRunFiles)
+		return m, nil
+	}
+	m.openedFiles = ""
+	return m, openRunFilesCmd(side.paths)
+}
+
+// renderOpenFallback offers the file:// URL of a file no opener could open,
+// and the local server for machines without a browser.
+func (m ResultsModel) renderOpenFallback() []string {
+	if m.openFallback == "" {
+		return nil
+	}
+	hint := lipgloss.NewStyle().Foreground(styles.GrayMedium)
+	link := lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	lines := []string{hint.Render("Open it in a browser: ") + link.Render(bridge.FileURL(m.openFallback))}
+	switch {
+	case m.serveURL != "":
+		lines = append(lines, hint.Render("Serving it at ")+link.Render(m.serveURL))
+	case m.serveError != "":
+		lines = append(lines, styles.ErrorStyle.Render(m.serveError))
+	default:
+		lines = append(lines, hint.Render("Press W to serve it on localhost, or set "+bridge.OpenerEnv+" to your opener"))
+	}
+	return lines
+}
+
+// renderFileWarning reports when the saved results could not be read, or do
+// not match what the runner streamed while the benchmark ran.
+func (m ResultsModel) renderFileWarning() []string {
+	if m.entriesError != "" {
+		return []string{styles.ErrorStyle.Render("Could not read the results file: " + m.entriesError)}
+	}
+	if len(m.disagreements) == 0 {
+		return nil
+	}
+	warning := lipgloss.NewStyle().Foreground(styles.OrangeWarning)
+	lines := []string{warning.Bold(true).Render(fmt.Sprintf(
+		"⚠ The results file disagrees with the live progress in %d test(s); showing the file (J: open JSON)",
+		len(m.disagreements)))}
+	for _, disagreement := range m.disagreements[:min(len(m.disagreements), maxDisagreementsShown)] {
+		lines = append(lines, warning.Render("  "+disagreement))
+	}
+	if len(m.disagreements) > maxDisagreementsShown {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(fmt.Sprintf("  ... and %d more", len(m.disagreements)-maxDisagreementsShown)))
+	}
+	return lines
+}
+
+// openExport opens the export screen for the displayed run.
+func (m ResultsModel) openExport() (tea.Model, tea.Cmd) {
+	side, ok := m.compareSide()
+	if !ok {
+		m.setOpenError(errNoRunFiles)
+		return m, nil
+	}
+	runName := "run-" + m.state.RunStarted.UTC().Format("2006-01-02T15-04-05")
+	if len(side.paths) == 1 {
+		runName = filepath.Base(side.paths[0])
+	}
+	model := NewExportModel(m.state, side.paths, runName, m.state.TestDurations, m)
+	model.width, model.height = m.width, m.height
+	return model, model.Init()
+}
 
-	return newView(content)
+// setOpenError records why opening a file failed. When no opener worked, the
+// file is kept to offer its URL instead of the error alone.
+func (m *ResultsModel) setOpenError(err error) {
+	m.openError, m.openFallback, m.serveURL, m.serveError = "", "", "", ""
+	if err == nil {
+		return
+	}
+	m.openError = err.Error()
+	var openErr *bridge.OpenError
+	if errors.As(err, &openErr) {
+		m.openFallback = openErr.Path
+	}
+}
+
+func serveReportCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		url, err := bridge.ServeReport(path)
+		return reportServedMsg{url: url, err: err}
+	}
 }
 
 func (m ResultsModel) openResults() tea.Cmd {
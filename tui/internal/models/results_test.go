@@ -1,8 +1,18 @@
 package models
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
+
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestResultsViewPutsViewBenchmarksFirst(t *testing.T) {
@@ -15,3 +25,239 @@ func TestResultsViewPutsViewBenchmarksFirst(t *testing.T) {
 		t.Fatal("completed benchmark actions should put View benchmarks first")
 	}
 }
+
+func TestResultsViewListsBaselineRegressions(t *testing.T) {
+	model := NewResultsModel(&SharedState{Provider: "openai", Model: "gpt-5"})
+	updated, _ := model.Update(baselineCheckedMsg{checks: []analysis.Regression{
+		{Model: "gpt-5", TestName: "counter", Baseline: analysis.Outcome{Present: true, Correct: 8, Samples: 10}, Candidate: analysis.Outcome{Present: true, Correct: 8, Samples: 10}, PValue: 1},
+		{Model: "gpt-5", TestName: "effect", Baseline: analysis.Outcome{Present: true, Correct: 10, Samples: 10}, Candidate: analysis.Outcome{Present: true, Correct: 2, Samples: 10}, Delta: -0.8, PValue: 0.0007, ExceedsMargin: true, Significant: true},
+	}})
+
+	view := ansi.Strip(updated.(ResultsModel).View().Content)
+	if !strings.Contains(view, "1 of 2 tests regressed") || !strings.Contains(view, "100% → 20% (-80%) p=0.001 *") {
+		t.Fatalf("expected the effect regression to be listed, got:\n%s", view)
+	}
+	if strings.Contains(view, "counter         100%") {
+		t.Fatal("unchanged tests should not be listed as regressions")
+	}
+}
+
+// tableRow returns the cells of the table row naming label.
+func tableRow(view, label string) []string {
+	for _, line := range strings.Split(view, "\n") {
+		if fields := strings.Fields(line); slices.Contains(fields, label) {
+			return fields
+		}
+	}
+	return nil
+}
+
+func TestResultsViewShowsChosenPassAtK(t *testing.T) {
+	t.Setenv(passAtKEnv, "")
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o",
+		Results:  []TestResult{{TestName: "counter", Current: 10, Total: 10, Correct: 3, Passed: true, PassAtOne: 0.3, PassAtTen: 1}},
+	}
+	var model tea.Model = NewResultsModel(state)
+	var samples []results.Sample
+	for i := range 10 {
+		samples = append(samples, results.Sample{Index: i, Success: i < 3})
+	}
+	model, _ = model.Update(runEntriesLoadedMsg{entries: []results.Entry{{
+		TestName: "counter", ModelID: "gpt-4o", NumSamples: 10, NumCorrect: 3, Samples: samples,
+	}}})
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	for range len("1,10") {
+		model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	}
+	for _, r := range "2,5,11" {
+		model, _ = model.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if got := analysis.FormatKValues(state.PassAtK); got != "2,5,11" {
+		t.Fatalf("expected the chosen k values to be kept for the session, got %q", got)
+	}
+	view := ansi.Strip(model.View().Content)
+	for _, want := range []string{"PASS@2  PASS@5  PASS@11", "Average pass@2: 53% • pass@5: 92% • pass@11: —"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view:\n%s", want, view)
+		}
+	}
+	if row := tableRow(view, "counter"); strings.Join(row[len(row)-3:], " ") != "53% 92% —" {
+		t.Errorf("expected pass@2, pass@5 and a missing pass@11 in the counter row, got %v", row)
+	}
+}
+
+func TestResultsViewShowsModelMatrixForMultiModelRuns(t *testing.T) {
+	state := &SharedState{
+		Provider: "openai",
+		Model:    "gpt-4o,gpt-5",
+		Results:  []TestResult{{TestName: "counter", Current: 20, Total: 20, Passed: true, PassAtOne: 0.7}},
+	}
+	var model tea.Model = NewResultsModel(state)
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	model, _ = model.Update(runEntriesLoadedMsg{entries: []results.Entry{
+		{TestName: "counter", ModelID: "gpt-4o", NumSamples: 10, NumCorrect: 5, Pass1: 0.5, Pass10: 1},
+		{TestName: "effect", ModelID: "gpt-4o", NumSamples: 10, NumCorrect: 3, Pass1: 0.3, Pass10: 1},
+		{TestName: "counter", ModelID: "gpt-5", NumSamples: 10, NumCorrect: 9, Pass1: 0.9, Pass10: 1},
+	}})
+
+	view := ansi.Strip(model.View().Content)
+	if !strings.Contains(view, "EFFECT") || !strings.Contains(view, "90/100") || !strings.Contains(view, "30/100") {
+		t.Fatalf("expected a model × test matrix, got:\n%s", view)
+	}
+	matrix := view[strings.Index(view, "OVERALL"):]
+	if strings.Index(matrix, "gpt-4o ") > strings.Index(matrix, "gpt-5 ") {
+		t.Fatalf("expected models sorted by name by default, got:\n%s", matrix)
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+	view = ansi.Strip(model.View().Content)
+	matrix = view[strings.Index(view, "OVERALL"):]
+	if !strings.Contains(matrix, "sorted by overall") || strings.Index(matrix, "gpt-5 ") > strings.Index(matrix, "gpt-4o ") {
+		t.Fatalf("expected gpt-5 first when sorted by overall score, got:\n%s", matrix)
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	if view := ansi.Strip(model.View().Content); strings.Contains(view, "OVERALL") {
+		t.Fatalf("expected M to switch back to the per-test table, got:\n%s", view)
+	}
+}
+
+func TestResultsRenderFromSavedFileAndWarnOnDisagreement(t *testing.T) {
+	state := &SharedState{
+		Provider:    "openai",
+		Model:       "gpt-4o",
+		ResultFiles: []string{"/benchmarks/benchmark-results-1.json"},
+		Results: []TestResult{
+			{TestName: "counter", Current: 10, Total: 10, Correct: 8, Passed: true, PassAtOne: 0.8},
+			{TestName: "effect", Current: 10, Total: 10, Correct: 5, Passed: true, PassAtOne: 0.5},
+		},
+	}
+	var model tea.Model = NewResultsModel(state)
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	model, _ = model.Update(runEntriesLoadedMsg{entries: []results.Entry{
+		{TestName: "counter", ModelID: "gpt-4o", NumSamples: 10, NumCorrect: 6, Pass1: 0.6, Pass10: 1},
+		{TestName: "effect", ModelID: "gpt-4o", NumSamples: 10, NumCorrect: 5, Pass1: 0.5, Pass10: 1},
+	}})
+
+	if state.Results[0].PassAtOne != 0.6 {
+		t.Fatalf("expected the saved file to replace the streamed results, got %#v", state.Results[0])
+	}
+	view := ansi.Strip(model.View().Content)
+	for _, want := range []string{
+		"results file disagrees with the live progress in 1 test(s)",
+		"counter: streamed 8/10 correct, file has 6/10",
+		"Open run JSON",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view:\n%s", want, view)
+		}
+	}
+}
+
+func TestResultsDisagreementsListMissingTests(t *testing.T) {
+	disagreements := resultsDisagreements(
+		[]TestResult{{TestName: "counter", Current: 10, Correct: 5, PassAtOne: 0.5}, {TestName: "inspect"}},
+		[]TestResult{{TestName: "counter", Current: 10, Correct: 5, PassAtOne: 0.5}, {TestName: "effect", Current: 10}},
+	)
+	if len(disagreements) != 1 || disagreements[0] != "effect: in the results file but never streamed" {
+		t.Fatalf("unexpected disagreements %q", disagreements)
+	}
+}
+
+func TestResultsOffersFileURLWhenNoOpenerWorks(t *testing.T) {
+	state := &SharedState{Results: []TestResult{{TestName: "counter", Current: 10, Total: 10, Passed: true, PassAtOne: 1}}}
+	var model tea.Model = NewResultsModel(state)
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 200, Height: 80})
+	model, _ = model.Update(resultsOpenedMsg{err: &bridge.OpenError{
+		Path:     "/srv/bench/benchmarks/benchmark-results-merged.html",
+		Failures: []string{"xdg-open: no method available for opening"},
+	}})
+
+	view := ansi.Strip(model.View().Content)
+	for _, want := range []string{
+		"no opener could open benchmark-results-merged.html (xdg-open: no method available for opening)",
+		"file:///srv/bench/benchmarks/benchmark-results-merged.html",
+		"Press W to serve it on localhost",
+	} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("expected %q in the results view, got:\n%s", want, view)
+		}
+	}
+
+	_, cmd := model.Update(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	if cmd == nil {
+		t.Fatal("expected W to start serving the report")
+	}
+	model, _ = model.Update(reportServedMsg{url: "http://127.0.0.1:4173/benchmark-results-merged.html"})
+	if view := ansi.Strip(model.View().Content); !strings.Contains(view, "Serving it at http://127.0.0.1:4173/benchmark-results-merged.html") {
+		t.Fatalf("expected the local URL, got:\n%s", view)
+	}
+}
+
+func TestResultsTableScrollsSortsAndFilters(t *testing.T) {
+	t.Setenv(passAtKEnv, "")
+	var tests []TestResult
+	for i := range 30 {
+		score := float64(i) / 29
+		tests = append(tests, TestResult{
+			TestName: fmt.Sprintf("test-%02d", i), Current: 10, Total: 10,
+			Correct: int(score * 10), Passed: score >= 0.5, PassAtOne: score,
+		})
+	}
+	var model tea.Model = NewResultsModel(&SharedState{Provider: "openai", Model: "gpt-5", Results: tests})
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	view := ansi.Strip(model.View().Content)
+	if tableRow(view, "test-00") == nil || tableRow(view, "test-29") != nil || !strings.Contains(view, "rows 1–") {
+		t.Fatalf("expected the first rows with a scroll position, got:\n%s", view)
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnd})
+	view = ansi.Strip(model.View().Content)
+	if tableRow(view, "test-29") == nil || tableRow(view, "test-00") != nil {
+		t.Fatalf("expected End to reach the last row, got:\n%s", view)
+	}
+	if !strings.Contains(view, "TEST") || !strings.Contains(view, "PASS@1") {
+		t.Fatalf("expected the header to stay while scrolled, got:\n%s", view)
+	}
+
+	// Tab sorts by name, then by pass@1 highest first.
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyHome})
+	view = ansi.Strip(model.View().Content)
+	if !strings.Contains(view, "PASS@1↓") || strings.Index(view, "test-29") > strings.Index(view, "test-28") {
+		t.Fatalf("expected rows sorted by pass@1, got:\n%s", view)
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'v', Text: "v"})
+	view = ansi.Strip(model.View().Content)
+	if !strings.Contains(view, "failing only (15 of 30)") || tableRow(view, "test-29") != nil {
+		t.Fatalf("expected only failing tests, got:\n%s", view)
+	}
+	if model.(ResultsModel).selectedOption != 0 {
+		t.Fatal("table keys should not move the action selection")
+	}
+}
+
+func TestResultsHelpFitsNarrowTerminals(t *testing.T) {
+	var model tea.Model = NewResultsModel(&SharedState{Provider: "openai", Model: "gpt-5"})
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 80, Height: 40})
+
+	view := ansi.Strip(model.View().Content)
+	for _, keys := range []string{"Tab: Sort", "Shift+Tab: Reverse", "V: Filter"} {
+		if !strings.Contains(view, keys) {
+			t.Errorf("help is missing %q:\n%s", keys, view)
+		}
+	}
+	for _, line := range resultsHelp {
+		if width := ansi.StringWidth(line); width > 80 {
+			t.Errorf("help line is %d columns wide: %s", width, line)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"svelte-bench/tui/internal/bridge"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// maxDisagreementsShown caps the mismatches listed on the results screen.
+const maxDisagreementsShown = 3
+
+type runFilesOpenedMsg struct {
+	paths []string
+	err   error
+}
+
+// openRunFilesCmd opens each results file of a run in the default application.
+func openRunFilesCmd(paths []string) tea.Cmd {
+	return func() tea.Msg {
+		for _, path := range paths {
+			if err := bridge.OpenFile(path); err != nil {
+				return runFilesOpenedMsg{err: err}
+			}
+		}
+		return runFilesOpenedMsg{paths: paths}
+	}
+}
+
+// resultsDisagreements lists the tests whose streamed progress differs from
+// the saved results: a test missing on either side, or different sample,
+// correct or pass@1 figures.
+func resultsDisagreements(streamed, saved []TestResult) []string {
+	savedByTest := make(map[string]TestResult, len(saved))
+	for _, result := range saved {
+		savedByTest[result.TestName] = result
+	}
+
+	var disagreements []string
+	seen := make(map[string]bool, len(streamed))
+	for _, live := range streamed {
+		seen[live.TestName] = true
+		file, ok := savedByTest[live.TestName]
+		switch {
+		case !ok:
+			if live.Current > 0 {
+				disagreements = append(disagreements, fmt.Sprintf("%s: streamed but not in the results file", live.TestName))
+			}
+		case live.Current != file.Current || live.Correct != file.Correct:
+			disagreements = append(disagreements, fmt.Sprintf("%s: streamed %d/%d correct, file has %d/%d",
+				live.TestName, live.Correct, live.Current, file.Correct, file.Current))
+		case math.Abs(live.PassAtOne-file.PassAtOne) > 1e-6:
+			disagreements = append(disagreements, fmt.Sprintf("%s: streamed pass@1 %.1f%%, file has %.1f%%",
+				live.TestName, live.PassAtOne*100, file.PassAtOne*100))
+		}
+	}
+	for _, file := range saved {
+		if !seen[file.TestName] {
+			disagreements = append(disagreements, fmt.Sprintf("%s: in the results file but never streamed", file.TestName))
+		}
+	}
+	return disagreements
+}
@@ -0,0 +1,300 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/styles"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// maxSampleErrorLines caps the error list so the code stays visible.
+const maxSampleErrorLines = 6
+
+type samplesLoadedMsg struct {
+	entries []results.Entry
+	err     error
+}
+
+// SamplesModel steps through the generated samples of a run, one test and
+// model at a time, showing each sample's code and test errors.
+type SamplesModel struct {
+	state        *SharedState
+	paths        []string
+	entries      []results.Entry
+	entryIndex   int
+	sampleIndex  int
+	scrollOffset int
+	back         tea.Model
+	loading      bool
+	error        string
+	width        int
+	height       int
+}
+
+// NewSamplesModel loads the samples stored in paths. Going back returns to
+// back.
+func NewSamplesModel(state *SharedState, paths []string, back tea.Model) SamplesModel {
+	return SamplesModel{
+		state:   state,
+		paths:   paths,
+		back:    back,
+		loading: true,
+		width:   80,
+		height:  24,
+	}
+}
+
+func (m SamplesModel) Init() tea.Cmd {
+	paths := m.paths
+	return func() tea.Msg {
+		entries, err := loadEntries(paths)
+		return samplesLoadedMsg{entries: entries, err: err}
+	}
+}
+
+func (m SamplesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case samplesLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.entries = msg.entries
+		sort.SliceStable(m.entries, func(i, j int) bool {
+			if m.entries[i].TestName != m.entries[j].TestName {
+				return m.entries[i].TestName < m.entries[j].TestName
+			}
+			return m.entries[i].ModelID < m.entries[j].ModelID
+		})
+		for i := range m.entries {
+			sort.SliceStable(m.entries[i].Samples, func(a, b int) bool {
+				return m.entries[i].Samples[a].Index < m.entries[i].Samples[b].Index
+			})
+		}
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			return m.back, nil
+		case "tab":
+			if len(m.entries) > 0 {
+				m.selectEntry((m.entryIndex + 1) % len(m.entries))
+			}
+		case "shift+tab":
+			if len(m.entries) > 0 {
+				m.selectEntry((m.entryIndex + len(m.entries) - 1) % len(m.entries))
+			}
+		case "n", "]":
+			if m.sampleIndex < len(m.samples())-1 {
+				m.selectSample(m.sampleIndex + 1)
+			}
+		case "p", "[":
+			if m.sampleIndex > 0 {
+				m.selectSample(m.sampleIndex - 1)
+			}
+		case "f":
+			m.nextFailure()
+		case "up":
+			if m.scrollOffset > 0 {
+				m.scrollOffset--
+			}
+		case "down":
+			if m.scrollOffset < m.maxScroll() {
+				m.scrollOffset++
+			}
+		case "pgup":
+			m.scrollOffset = max(0, m.scrollOffset-m.maxCodeLines())
+		case "pgdown":
+			m.scrollOffset = min(m.maxScroll(), m.scrollOffset+m.maxCodeLines())
+		}
+	}
+
+	return m, nil
+}
+
+func (m *SamplesModel) selectEntry(index int) {
+	m.entryIndex = index
+	m.selectSample(0)
+}
+
+func (m *SamplesModel) selectSample(index int) {
+	m.sampleIndex = index
+	m.scrollOffset = 0
+}
+
+// nextFailure moves to the next failed sample, continuing into later tests
+// and wrapping around to the first one.
+func (m *SamplesModel) nextFailure() {
+	total := 0
+	for _, entry := range m.entries {
+		total += len(entry.Samples)
+	}
+	entryIndex, sampleIndex := m.entryIndex, m.sampleIndex
+	for range total {
+		sampleIndex++
+		for sampleIndex >= len(m.entries[entryIndex].Samples) {
+			entryIndex = (entryIndex + 1) % len(m.entries)
+			sampleIndex = 0
+		}
+		if !m.entries[entryIndex].Samples[sampleIndex].Success {
+			m.entryIndex = entryIndex
+			m.selectSample(sampleIndex)
+			return
+		}
+	}
+}
+
+func (m SamplesModel) samples() []results.Sample {
+	if m.entryIndex >= len(m.entries) {
+		return nil
+	}
+	return m.entries[m.entryIndex].Samples
+}
+
+func (m SamplesModel) currentSample() (results.Sample, bool) {
+	samples := m.samples()
+	if m.sampleIndex >= len(samples) {
+		return results.Sample{}, false
+	}
+	return samples[m.sampleIndex], true
+}
+
+func (m SamplesModel) codeLines() []string {
+	sample, ok := m.currentSample()
+	if !ok {
+		return nil
+	}
+	return styles.HighlightSvelte(strings.TrimRight(sample.Code, "\n"))
+}
+
+func (m SamplesModel) errorLines() []string {
+	sample, ok := m.currentSample()
+	if !ok {
+		return nil
+	}
+	var lines []string
+	for _, message := range sample.Errors {
+		for i, line := range strings.Split(strings.TrimSpace(message), "\n") {
+			prefix := "  • "
+			if i > 0 {
+				prefix = "    "
+			}
+			lines = append(lines, truncateText(prefix+line, max(20, m.width-6)))
+		}
+	}
+	if len(lines) > maxSampleErrorLines {
+		hidden := len(lines) - maxSampleErrorLines + 1
+		lines = append(lines[:maxSampleErrorLines-1], fmt.Sprintf("    ... %d more lines", hidden))
+	}
+	return lines
+}
+
+func (m SamplesModel) maxCodeLines() int {
+	// Headings, status, the error list and help take the remaining rows.
+	reserved := 14 + len(m.errorLines())
+	if len(m.errorLines()) > 0 {
+		reserved += 2
+	}
+	return max(3, m.height-reserved)
+}
+
+func (m SamplesModel) maxScroll() int {
+	return max(0, len(m.codeLines())-m.maxCodeLines())
+}
+
+func (m SamplesModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("SAMPLE DRILL-DOWN")
+	lines = append(lines, styles.SectionLabelStyle.Render("RESULTS / SAMPLES"), title, "")
+
+	if m.loading {
+		lines = append(lines, styles.ProgressTextStyle.Render("Loading samples..."))
+	} else if m.error != "" {
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	} else if len(m.entries) == 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("This run has no stored samples"))
+	} else {
+		lines = append(lines, m.renderBody()...)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("Tab: Next test • N/P: Next/prev sample • F: Next failure • ↑/↓/PgUp/PgDn: Scroll • ←: Back • Q/Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m SamplesModel) renderBody() []string {
+	entry := m.entries[m.entryIndex]
+	lines := []string{
+		lipgloss.NewStyle().Foreground(styles.OrangeMid).Render(
+			fmt.Sprintf("%s • %s", entry.TestName, entry.ModelID)) +
+			lipgloss.NewStyle().Foreground(styles.GrayDim).Render(
+				fmt.Sprintf("  (test %d of %d)", m.entryIndex+1, len(m.entries))),
+		lipgloss.NewStyle().Foreground(scoreColor(entry.Pass1)).Render(
+			fmt.Sprintf("pass@1 %.0f%% • %d/%d samples correct", entry.Pass1*100, entry.NumCorrect, entry.NumSamples)),
+		"",
+	}
+
+	sample, ok := m.currentSample()
+	if !ok {
+		return append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("No samples stored for this test"))
+	}
+
+	status := lipgloss.NewStyle().Foreground(styles.OrangeSuccess).Bold(true).Render("✓ passed")
+	if !sample.Success {
+		status = lipgloss.NewStyle().Foreground(styles.OrangeError).Bold(true).Render("✗ failed")
+	}
+	sampleLabel := fmt.Sprintf("Sample #%d (%d of %d)", sample.Index, m.sampleIndex+1, len(m.samples()))
+	if sample.Temperature != nil {
+		sampleLabel += fmt.Sprintf(" • temperature %g", *sample.Temperature)
+	}
+	lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayLight).Render(sampleLabel)+"  "+status)
+
+	if errors := m.errorLines(); len(errors) > 0 {
+		lines = append(lines, "", styles.SectionLabelStyle.Render("ERRORS"))
+		for _, line := range errors {
+			lines = append(lines, styles.ErrorStyle.Render(line))
+		}
+	}
+
+	lines = append(lines, "", styles.SectionLabelStyle.Render("CODE"))
+	code := m.codeLines()
+	end := min(len(code), m.scrollOffset+m.maxCodeLines())
+	numberWidth := len(fmt.Sprint(len(code)))
+	for i := m.scrollOffset; i < end; i++ {
+		number := lipgloss.NewStyle().Foreground(styles.GrayDim).Render(fmt.Sprintf("%*d ", numberWidth, i+1))
+		lines = append(lines, number+code[i])
+	}
+	if end < len(code) {
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayDim).
+			Render(fmt.Sprintf("... %d more lines", len(code)-end)))
+	}
+	return lines
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"svelte-bench/tui/internal/config"
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestSamplesStepsThroughCodeAndErrors(t *testing.T) {
+	state := &SharedState{Config: &config.Config{APIKeys: map[string]string{}}}
+	back := NewResultsModel(state)
+	samples := NewSamplesModel(state, []string{"/tmp/run.json"}, back)
+	updated, _ := samples.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	updated, _ = updated.(SamplesModel).Update(samplesLoadedMsg{entries: []results.Entry{
+		{TestName: "effect", ModelID: "gpt-5", NumSamples: 2, NumCorrect: 1, Pass1: 0.5, Samples: []results.Sample{
+			{Index: 1, Code: "<script>\n  let count = $state(0);\n</script>", Errors: []string{"expected 2 to be 1"}},
+			{Index: 0, Code: "<p>ok</p>", Success: true},
+		}},
+		{TestName: "counter", ModelID: "gpt-5", NumSamples: 1, NumCorrect: 1, Pass1: 1, Samples: []results.Sample{
+			{Index: 0, Code: "<button>+</button>", Success: true},
+		}},
+	}})
+	samples = updated.(SamplesModel)
+
+	view := ansi.Strip(samples.View().Content)
+	if !strings.Contains(view, "counter • gpt-5") || !strings.Contains(view, "<button>+</button>") {
+		t.Fatalf("expected tests in name order starting with counter, got:\n%s", view)
+	}
+
+	updated, _ = samples.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	samples = updated.(SamplesModel)
+	view = ansi.Strip(samples.View().Content)
+	if !strings.Contains(view, "Sample #1 (2 of 2)") || !strings.Contains(view, "✗ failed") {
+		t.Fatalf("expected the failed effect sample, got:\n%s", view)
+	}
+	if !strings.Contains(view, "expected 2 to be 1") || !strings.Contains(view, "let count = $state(0);") {
+		t.Fatalf("expected errors and code of the failed sample, got:\n%s", view)
+	}
+
+	returned, _ := samples.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if _, ok := returned.(ResultsModel); !ok {
+		t.Fatalf("left should return to the results view, got %T", returned)
+	}
+}
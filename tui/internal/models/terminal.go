@@ -0,0 +1,126 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+const (
+	terminalIntegrationEnv = "TUI_TERMINAL_INTEGRATION"
+	terminalNotifyEnv      = "TUI_NOTIFY"
+)
+
+// Notification styles accepted by TUI_NOTIFY.
+const (
+	notifyBell   = "bell"
+	notifyOSC9   = "osc9"
+	notifyOSC777 = "osc777"
+	notifyNone   = "none"
+)
+
+// terminalIntegration controls the escape sequences the benchmark screen sends
+// so long runs remain visible from background tabs. Everything is on by
+// default; TUI_TERMINAL_INTEGRATION=false turns it off for terminals that echo
+// unknown OSC sequences, and TUI_NOTIFY selects how completion is announced.
+type terminalIntegration struct {
+	enabled bool
+	notify  string
+	// err reports invalid TUI_TERMINAL_INTEGRATION or TUI_NOTIFY values,
+	// which keep their defaults.
+	err error
+}
+
+func loadTerminalIntegration() terminalIntegration {
+	integration := terminalIntegration{enabled: true, notify: notifyBell}
+	var errs []error
+	if value := strings.TrimSpace(os.Getenv(terminalIntegrationEnv)); value != "" {
+		if enabled, err := strconv.ParseBool(value); err == nil {
+			integration.enabled = enabled
+		} else {
+			errs = append(errs, fmt.Errorf("%s=%q is not true or false", terminalIntegrationEnv, value))
+		}
+	}
+
+	value := strings.TrimSpace(os.Getenv(terminalNotifyEnv))
+	switch notify := strings.ToLower(value); notify {
+	case notifyBell, notifyOSC9, notifyOSC777, notifyNone:
+		integration.notify = notify
+	case "", "true", "1":
+	case "false", "0", "off":
+		integration.notify = notifyNone
+	default:
+		errs = append(errs, fmt.Errorf("%s=%q is not one of %s, %s, %s or %s",
+			terminalNotifyEnv, value, notifyBell, notifyOSC9, notifyOSC777, notifyNone))
+	}
+	integration.err = errors.Join(errs...)
+
+	if !integration.enabled {
+		integration.notify = notifyNone
+	}
+	return integration
+}
+
+// decorate adds the OSC 9;4 taskbar progress and the window title to a view.
+func (t terminalIntegration) decorate(view *tea.View, percent int, failed bool, models string) {
+	if !t.enabled {
+		return
+	}
+
+	state := tea.ProgressBarDefault
+	if failed {
+		state = tea.ProgressBarError
+	}
+	view.ProgressBar = tea.NewProgressBar(state, percent)
+
+	title := fmt.Sprintf("svelte-bench %d%%", percent)
+	if failed {
+		title = fmt.Sprintf("svelte-bench failed %d%%", percent)
+	}
+	if models != "" {
+		title += " " + models
+	}
+	view.WindowTitle = title
+}
+
+// clear resets the taskbar progress and the window title set by decorate, for
+// the screens that follow a run.
+func (t terminalIntegration) clear(view *tea.View) {
+	if !t.enabled {
+		return
+	}
+	view.ProgressBar = tea.NewProgressBar(tea.ProgressBarNone, 0)
+	view.WindowTitle = ""
+}
+
+// notifyCmd announces a finished or failed run with the configured style.
+func (t terminalIntegration) notifyCmd(title, body string) tea.Cmd {
+	if sequence := t.notification(title, body); sequence != "" {
+		return tea.Raw(sequence)
+	}
+	return nil
+}
+
+func (t terminalIntegration) notification(title, body string) string {
+	// Notification payloads are delimited by ';' and terminated by BEL, so keep
+	// both out of user-visible text such as error messages.
+	clean := strings.NewReplacer(";", ",", "\a", "", "\x1b", "", "\n", " ", "\r", " ")
+	title = clean.Replace(title)
+	body = clean.Replace(body)
+
+	switch t.notify {
+	case notifyBell:
+		return string(rune(ansi.BEL))
+	case notifyOSC9:
+		return ansi.Notify(title + ": " + body)
+	case notifyOSC777:
+		return ansi.URxvtExt("notify", title, body)
+	default:
+		return ""
+	}
+}
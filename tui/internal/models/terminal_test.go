@@ -0,0 +1,124 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestTerminalIntegrationIsOptOut(t *testing.T) {
+	t.Setenv(terminalIntegrationEnv, "")
+	t.Setenv(terminalNotifyEnv, "")
+	if integration := loadTerminalIntegration(); !integration.enabled || integration.notify != notifyBell {
+		t.Fatalf("expected progress and bell by default, got %#v", integration)
+	}
+
+	t.Setenv(terminalIntegrationEnv, "false")
+	t.Setenv(terminalNotifyEnv, "osc9")
+	integration := loadTerminalIntegration()
+	if integration.enabled || integration.notify != notifyNone {
+		t.Fatalf("expected opt-out to disable every sequence, got %#v", integration)
+	}
+
+	view := tea.NewView("")
+	integration.decorate(&view, 50, false, "gpt-5")
+	if view.ProgressBar != nil || view.WindowTitle != "" {
+		t.Fatal("disabled integration should not decorate the view")
+	}
+}
+
+func TestBenchmarkViewReportsTerminalProgressAndTitle(t *testing.T) {
+	t.Setenv(terminalIntegrationEnv, "")
+	state := &SharedState{Provider: "openai", Model: "gpt-5"}
+	model := NewBenchmarkModel(state)
+	model.currentCount = model.totalSamples / 2
+
+	view := model.View()
+	if view.ProgressBar == nil || view.ProgressBar.State != tea.ProgressBarDefault || view.ProgressBar.Value != 50 {
+		t.Fatalf("expected OSC 9;4 progress at 50%%, got %#v", view.ProgressBar)
+	}
+	if view.WindowTitle != "svelte-bench 50% gpt-5" {
+		t.Fatalf("unexpected window title %q", view.WindowTitle)
+	}
+
+	state.Error = "provider exploded"
+	if view := model.View(); view.ProgressBar.State != tea.ProgressBarError {
+		t.Fatalf("expected error progress state after a failure, got %v", view.ProgressBar.State)
+	}
+}
+
+func TestTerminalNotificationsSanitizeDelimiters(t *testing.T) {
+	integration := terminalIntegration{enabled: true, notify: notifyOSC777}
+	sequence := integration.notification("svelte-bench failed", "bad;key\x07")
+	if sequence != "\x1b]777;notify;svelte-bench failed;bad,key\x07" {
+		t.Fatalf("unexpected OSC 777 sequence %q", sequence)
+	}
+
+	integration.notify = notifyBell
+	if got := integration.notification("a", "b"); got != "\a" {
+		t.Fatalf("expected a bell, got %q", got)
+	}
+}
+
+func TestBenchmarkNotifiesOnce(t *testing.T) {
+	t.Setenv(terminalIntegrationEnv, "")
+	t.Setenv(terminalNotifyEnv, "")
+	state := &SharedState{Provider: "openai", Model: "gpt-5"}
+	model := NewBenchmarkModel(state)
+	state.Error = "Benchmark incomplete"
+
+	updated, cmd := model.Update(benchmarkCompleteMsg{})
+	if cmd == nil {
+		t.Fatal("expected a failed run to notify the terminal")
+	}
+	if raw, ok := cmd().(tea.RawMsg); !ok || !strings.Contains(raw.Msg.(string), "\a") {
+		t.Fatalf("expected a raw bell message, got %#v", cmd())
+	}
+	if _, cmd := updated.(BenchmarkModel).Update(benchmarkCompleteMsg{}); cmd != nil {
+		t.Fatal("expected a single notification per run")
+	}
+}
+
+func TestTerminalIntegrationReportsInvalidSettings(t *testing.T) {
+	t.Setenv(terminalIntegrationEnv, "maybe")
+	t.Setenv(terminalNotifyEnv, "Growl")
+	integration := loadTerminalIntegration()
+	if !integration.enabled || integration.notify != notifyBell {
+		t.Fatalf("expected invalid values to keep the defaults, got %#v", integration)
+	}
+	for _, want := range []string{`TUI_TERMINAL_INTEGRATION="maybe" is not true or false`, `TUI_NOTIFY="Growl" is not one of`} {
+		if integration.err == nil || !strings.Contains(integration.err.Error(), want) {
+			t.Fatalf("expected %q reported, got %v", want, integration.err)
+		}
+	}
+
+	model := NewBenchmarkModel(&SharedState{Provider: "openai", Model: "gpt-5"})
+	model.width, model.height = 160, 40
+	if view := ansi.Strip(model.View().Content); !strings.Contains(view, `⚠ TUI_NOTIFY="Growl"`) {
+		t.Fatalf("expected the benchmark screen to show the invalid setting:\n%s", view)
+	}
+
+	t.Setenv(terminalIntegrationEnv, "0")
+	t.Setenv(terminalNotifyEnv, "none")
+	if integration := loadTerminalIntegration(); integration.err != nil {
+		t.Fatalf("expected valid values to pass, got %v", integration.err)
+	}
+}
+
+func TestResultsClearTerminalProgressAndTitle(t *testing.T) {
+	dir := benchmarkProject(t)
+	t.Setenv(terminalIntegrationEnv, "")
+	path := writeResultsFile(t, dir, "benchmark-results-2025-01-02T00-00-00.000Z.json",
+		storedEntry("gpt-5", "counter", 6, 10))
+
+	results := finishRun(t, &SharedState{Provider: "openai", Model: "gpt-5"}, path)
+	view := results.View()
+	if view.ProgressBar == nil || view.ProgressBar.State != tea.ProgressBarNone {
+		t.Fatalf("expected the results to reset the OSC 9;4 progress, got %#v", view.ProgressBar)
+	}
+	if view.WindowTitle != "" {
+		t.Fatalf("expected the window title cleared, got %q", view.WindowTitle)
+	}
+}
@@ -0,0 +1,303 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"svelte-bench/tui/internal/analysis"
+	"svelte-bench/tui/internal/bridge"
+	"svelte-bench/tui/internal/results"
+	"svelte-bench/tui/internal/styles"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// maxTrendSuggestions caps the models offered below the query input.
+const maxTrendSuggestions = 5
+
+// maxTrendRecentRuns caps the runs listed below the charts.
+const maxTrendRecentRuns = 5
+
+type trendsLoadedMsg struct {
+	entries      []results.Entry
+	suiteWarning string
+	err          error
+}
+
+// TrendsModel charts the overall score and per-test pass@1 of a model, or a
+// model family, over the dates of its stored runs.
+type TrendsModel struct {
+	state        *SharedState
+	queryInput   textinput.Model
+	match        analysis.TrendMatch
+	entries      []results.Entry
+	suiteWarning string
+	keys         []string
+	suggestions  []string
+	selected     int
+	trend        analysis.Trend
+	back         tea.Model
+	loading      bool
+	loadingStart time.Time
+	error        string
+	width        int
+	height       int
+}
+
+// NewTrendsModel creates the trends screen. A non-empty query selects that
+// exact provider/model; otherwise the user types a model or family prefix.
+// Going back returns to back, or to provider selection when back is nil.
+func NewTrendsModel(state *SharedState, query string, back tea.Model) TrendsModel {
+	queryInput := textinput.New()
+	queryInput.Placeholder = "provider/model or family prefix, e.g. openai/gpt-5"
+	queryInput.SetWidth(60)
+	queryInputStyles := queryInput.Styles()
+	queryInputStyles.Focused.Prompt = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	queryInputStyles.Focused.Text = lipgloss.NewStyle().Foreground(styles.OrangePrimary)
+	queryInputStyles.Blurred = queryInputStyles.Focused
+	queryInput.SetStyles(queryInputStyles)
+	queryInput.SetValue(query)
+	queryInput.CursorEnd()
+	queryInput.Focus()
+
+	match := analysis.TrendPrefix
+	if query != "" {
+		match = analysis.TrendExact
+	}
+
+	return TrendsModel{
+		state:        state,
+		queryInput:   queryInput,
+		match:        match,
+		selected:     -1,
+		back:         back,
+		loading:      true,
+		loadingStart: time.Now(),
+		width:        80,
+		height:       24,
+	}
+}
+
+func (m TrendsModel) Init() tea.Cmd {
+	return loadTrends
+}
+
+func loadTrends() tea.Msg {
+	entries, paths, err := loadStoredEntries()
+	return trendsLoadedMsg{entries: entries, suiteWarning: bridge.SuiteWarning(paths), err: err}
+}
+
+func (m TrendsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.queryInput.SetWidth(min(60, max(12, m.width-20)))
+		return m, nil
+
+	case trendsLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.error = msg.err.Error()
+			return m, nil
+		}
+		m.entries = msg.entries
+		m.suiteWarning = msg.suiteWarning
+		m.keys = analysis.ModelKeys(msg.entries)
+		m.rebuild()
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if DoubleEscapeRequestsExit() {
+				return m, tea.Quit
+			}
+		case "left":
+			if m.back != nil {
+				return m.back, nil
+			}
+			model := NewProviderModelSelectModel(m.state)
+			return model, model.Init()
+		case "tab":
+			if m.match == analysis.TrendExact {
+				m.match = analysis.TrendPrefix
+			} else {
+				m.match = analysis.TrendExact
+			}
+			m.rebuild()
+		case "up":
+			if m.selected >= 0 {
+				m.selected--
+			}
+		case "down":
+			if m.selected < len(m.suggestions)-1 {
+				m.selected++
+			}
+		case "enter":
+			if m.selected >= 0 && m.selected < len(m.suggestions) {
+				m.queryInput.SetValue(m.suggestions[m.selected])
+				m.queryInput.CursorEnd()
+				m.match = analysis.TrendExact
+				m.rebuild()
+			}
+		default:
+			previous := m.queryInput.Value()
+			var cmd tea.Cmd
+			m.queryInput, cmd = m.queryInput.Update(msg)
+			if m.queryInput.Value() != previous {
+				m.match = analysis.TrendPrefix
+				m.rebuild()
+			}
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// rebuild recomputes the trend and the models offered for the query.
+func (m *TrendsModel) rebuild() {
+	query := strings.ToLower(strings.TrimSpace(m.queryInput.Value()))
+	m.trend = analysis.BuildTrend(m.entries, query, m.match)
+	m.suggestions = nil
+	m.selected = -1
+	if query == "" {
+		return
+	}
+	for _, key := range m.keys {
+		if key == query && m.match == analysis.TrendExact {
+			m.suggestions = nil
+			return
+		}
+		if strings.Contains(key, query) && len(m.suggestions) < maxTrendSuggestions {
+			m.suggestions = append(m.suggestions, key)
+		}
+	}
+}
+
+// chartWidth is the number of runs each sparkline shows, the most recent ones
+// when there are more runs than fit.
+func (m TrendsModel) chartWidth() int {
+	return max(8, m.width-42)
+}
+
+func (m TrendsModel) View() tea.View {
+	var lines []string
+
+	title := styles.HeadingStyle.Render("MODEL TRENDS")
+	lines = append(lines, styles.SectionLabelStyle.Render("HISTORY / TRENDS"), title, "")
+
+	lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayMedium).Render("MODEL  ")+m.queryInput.View())
+	for i, suggestion := range m.suggestions {
+		if i == m.selected {
+			lines = append(lines, styles.SelectedRowStyle.Render("> "+suggestion))
+		} else {
+			lines = append(lines, lipgloss.NewStyle().Foreground(styles.GrayLight).Render("  "+suggestion))
+		}
+	}
+	lines = append(lines, "")
+
+	switch {
+	case m.loading:
+		spinner := styles.SpinnerFrames[int(time.Since(m.loadingStart).Milliseconds()/100)%len(styles.SpinnerFrames)]
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.OrangePrimary).
+			Render(spinner+" Scanning benchmarks..."))
+	case m.error != "":
+		lines = append(lines, styles.ErrorStyle.Render("Error: "+m.error))
+	case strings.TrimSpace(m.queryInput.Value()) == "":
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayMedium).
+			Render(fmt.Sprintf("Type a model or family prefix; %d models have stored runs", len(m.keys))))
+	case len(m.trend.Points) == 0:
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(styles.GrayMedium).
+			Render(fmt.Sprintf("No stored runs match (%s)", m.match)))
+	default:
+		lines = append(lines, renderSuiteWarning(m.suiteWarning, m.width)...)
+		lines = append(lines, m.renderTrend()...)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("Type: Model • ↑/↓ Enter: Pick model • Tab: Exact/prefix • ←: Back • Ctrl+C: Quit"))
+
+	content := lipgloss.NewStyle().
+		Padding(2, 2).
+		MaxWidth(m.width).
+		MaxHeight(m.height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return newView(content)
+}
+
+func (m TrendsModel) renderTrend() []string {
+	points := m.trend.Points
+	first, last := points[0], points[len(points)-1]
+	lines := []string{lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(fmt.Sprintf("%s • %d model(s) • %d runs • %s → %s",
+			m.match, len(m.trend.Models), len(points),
+			first.Date.Local().Format("2006-01-02"), last.Date.Local().Format("2006-01-02"))), ""}
+
+	overall := m.trend.OverallSeries()
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, value := range overall {
+		low, high = math.Min(low, value), math.Max(high, value)
+	}
+	lines = append(lines, m.renderSeries("OVERALL", overall, true)+lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render(fmt.Sprintf("  min %.0f%% • max %.0f%% • %+.0f%% since first run",
+			low*100, high*100, (last.Overall-first.Overall)*100)))
+	for _, test := range m.trend.Tests {
+		lines = append(lines, m.renderSeries(test, m.trend.TestSeries(test), false))
+	}
+
+	lines = append(lines, "", styles.SectionLabelStyle.Render("RECENT RUNS"))
+	for i := len(points) - 1; i >= max(0, len(points)-maxTrendRecentRuns); i-- {
+		point := points[i]
+		lines = append(lines, fmt.Sprintf("  %s  %s %s",
+			lipgloss.NewStyle().Foreground(styles.GrayMedium).Render(point.Date.Local().Format("2006-01-02 15:04")),
+			lipgloss.NewStyle().Width(28).Foreground(styles.GrayLight).Render(truncateText(point.Model, 28)),
+			lipgloss.NewStyle().Width(4).Align(lipgloss.Right).Foreground(scoreColor(point.Overall)).Render(fmt.Sprintf("%.0f%%", point.Overall*100))))
+	}
+	return lines
+}
+
+// renderSeries draws one labelled sparkline on a fixed 0–100% scale, followed
+// by the latest value.
+func (m TrendsModel) renderSeries(label string, series []float64, bold bool) string {
+	if len(series) > m.chartWidth() {
+		series = series[len(series)-m.chartWidth():]
+	}
+	latest := math.NaN()
+	for i := len(series) - 1; i >= 0; i-- {
+		if !math.IsNaN(series[i]) {
+			latest = series[i]
+			break
+		}
+	}
+
+	labelStyle := lipgloss.NewStyle().Width(14).Foreground(styles.GrayMedium)
+	if bold {
+		labelStyle = labelStyle.Foreground(styles.OrangeMid).Bold(true)
+	}
+	value := lipgloss.NewStyle().Width(5).Align(lipgloss.Right).Foreground(styles.GrayDim).Render("--")
+	chartColor := styles.GrayDim
+	if !math.IsNaN(latest) {
+		chartColor = scoreColor(latest)
+		value = lipgloss.NewStyle().Width(5).Align(lipgloss.Right).Foreground(chartColor).Bold(bold).Render(fmt.Sprintf("%.0f%%", latest*100))
+	}
+	chart := lipgloss.NewStyle().
+		Width(min(len(series), m.chartWidth())).
+		Foreground(chartColor).
+		Render(styles.Sparkline(series, 0, 1))
+	return fmt.Sprintf("  %s %s %s", labelStyle.Render(truncateText(label, 14)), chart, value)
+}
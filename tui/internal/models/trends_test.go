@@ -0,0 +1,41 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/results"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestTrendsViewChartsMatchingRuns(t *testing.T) {
+	day := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	var entries []results.Entry
+	for i, pass1 := range []float64{0, 0.5, 1} {
+		entries = append(entries, results.Entry{
+			Provider: "OpenAI", ModelID: "gpt-5", TestName: "counter", Pass1: pass1, NumSamples: 10,
+			Timestamp: day.Add(time.Duration(i) * 24 * time.Hour),
+		})
+	}
+	entries = append(entries, results.Entry{Provider: "Google", ModelID: "gemini-2.5-pro", TestName: "counter", Pass1: 1, Timestamp: day})
+
+	var model tea.Model = NewTrendsModel(&SharedState{}, "", nil)
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	model, _ = model.Update(trendsLoadedMsg{entries: entries})
+	for _, r := range "openai/gpt" {
+		model, _ = model.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+
+	view := ansi.Strip(model.View().Content)
+	for _, want := range []string{"openai/gpt-5", "3 runs", "OVERALL", "▁▅█", "+100% since first run"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "gemini") {
+		t.Fatalf("expected other models to be excluded:\n%s", view)
+	}
+}
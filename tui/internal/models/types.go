@@ -2,6 +2,7 @@ package models
 
 import (
 	"svelte-bench/tui/internal/config"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 )
@@ -34,9 +35,20 @@ type SharedState struct {
 	Model                    string
 	Parallel                 bool
 	Madmax                   bool
-	Results                  []TestResult
-	Completed                bool
-	Error                    string
+	// Profile is the config-file profile the session was started with. It
+	// presets the selection screens and the run's samples, tests, context
+	// and retry settings.
+	Profile     *config.Profile
+	Results     []TestResult
+	Completed   bool
+	Error       string
+	RunStarted  time.Time
+	ResultFiles []string
+	// TestDurations holds how long each model took per test in the live run,
+	// keyed by modelTestKey.
+	TestDurations map[string]time.Duration
+	// PassAtK holds the k values chosen on the results screen.
+	PassAtK []int
 }
 
 // TestResult holds results for a single test
@@ -44,6 +56,7 @@ type TestResult struct {
 	TestName     string
 	Current      int
 	Total        int
+	Correct      int
 	Passed       bool
 	PassAtOne    float64
 	PassAtTen    float64
@@ -62,3 +75,8 @@ const (
 	StatusCompleted
 	StatusFailed
 )
+
+// finished reports whether every sample of the test ran to a final status.
+func (t *TestResult) finished() bool {
+	return (t.Status == StatusCompleted || t.Status == StatusFailed) && t.Current >= t.Total
+}
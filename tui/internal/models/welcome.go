@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+	"strings"
 	"svelte-bench/tui/internal/config"
 	"svelte-bench/tui/internal/styles"
 
@@ -8,15 +10,32 @@ import (
 	"charm.land/lipgloss/v2"
 )
 
-// WelcomeModel is the welcome screen model
+// WelcomeModel is the welcome screen model. It offers the profiles of the
+// TUI config file next to configuring a run by hand.
 type WelcomeModel struct {
-	state  *SharedState
-	width  int
-	height int
+	state    *SharedState
+	settings config.Settings
+	// selected is 0 for manual setup, or 1 + the index of a profile.
+	selected int
+	error    string
+	width    int
+	height   int
 }
 
-// NewWelcomeModel creates a new welcome model
+// NewWelcomeModel creates a new welcome model with the profiles of the TUI
+// config file.
 func NewWelcomeModel(cfg *config.Config) WelcomeModel {
+	settings, err := LoadSettings()
+	m := NewWelcomeModelWithSettings(cfg, settings)
+	if err != nil {
+		m.error = err.Error()
+	}
+	return m
+}
+
+// NewWelcomeModelWithSettings creates a welcome model offering the profiles
+// of settings, focusing the default profile.
+func NewWelcomeModelWithSettings(cfg *config.Config, settings config.Settings) WelcomeModel {
 	state := &SharedState{
 		Config: cfg,
 	}
@@ -28,11 +47,18 @@ func NewWelcomeModel(cfg *config.Config) WelcomeModel {
 		}
 	}
 
-	return WelcomeModel{
-		state:  state,
-		width:  80,
-		height: 24,
+	m := WelcomeModel{
+		state:    state,
+		settings: settings,
+		width:    80,
+		height:   24,
+	}
+	for i, profile := range settings.Profiles {
+		if profile.Name == settings.DefaultProfile {
+			m.selected = i + 1
+		}
 	}
+	return m
 }
 
 func (m WelcomeModel) Init() tea.Cmd {
@@ -55,9 +81,27 @@ func (m WelcomeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			}
 
+		case "up":
+			if m.selected > 0 {
+				m.selected--
+			}
+		case "down":
+			if m.selected < len(m.settings.Profiles) {
+				m.selected++
+			}
+
 		case "enter":
-			model := NewProviderModelSelectModel(m.state)
-			return model, model.Init()
+			if m.selected == 0 {
+				m.state.Profile = nil
+				model := NewProviderModelSelectModel(m.state)
+				return model, model.Init()
+			}
+			model, cmd, err := StartProfile(m.state, m.settings.Profiles[m.selected-1])
+			if err != nil {
+				m.error = err.Error()
+				return m, nil
+			}
+			return model, cmd
 		}
 	}
 
@@ -74,13 +118,32 @@ func (m WelcomeModel) View() tea.View {
 		Render("HumanEval-style component benchmarks for Svelte 5")
 
 	lines = append(lines, title, subtitle, "", "")
+	lines = append(lines, renderEnvWarnings(m.state.Config, m.width)...)
+
+	var help string
+	if len(m.settings.Profiles) == 0 {
+		lines = append(lines, styles.ProgressTextStyle.Render("Press Enter to configure a benchmark run"))
+		if m.settings.Path != "" {
+			lines = append(lines, lipgloss.NewStyle().
+				Foreground(styles.GrayDim).
+				Render("Save run settings as profiles in "+m.settings.Path))
+		}
+		help = "Enter: Continue • Double Esc: Quit • Ctrl+C: Quit"
+	} else {
+		lines = append(lines, styles.SectionLabelStyle.Render("START A RUN"))
+		lines = append(lines, m.renderOption(0, "Configure manually", ""))
+		for i, profile := range m.settings.Profiles {
+			lines = append(lines, m.renderOption(i+1, profile.Name, profileSummary(profile)))
+		}
+		help = "↑/↓: Select • Enter: Start • Double Esc: Quit • Ctrl+C: Quit"
+	}
 
-	lines = append(lines, styles.ProgressTextStyle.Render("Press Enter to configure a benchmark run"))
+	if m.error != "" {
+		lines = append(lines, "", styles.ErrorStyle.Render("Error: "+m.error))
+	}
 
 	// Help text
 	lines = append(lines, "")
-	var help string
-	help = "Enter: Continue • Double Esc: Quit • Ctrl+C: Quit"
 	lines = append(lines, lipgloss.NewStyle().
 		Foreground(styles.GrayDim).
 		Render(help))
@@ -93,3 +156,57 @@ func (m WelcomeModel) View() tea.View {
 
 	return newView(content)
 }
+
+func (m WelcomeModel) renderOption(index int, name, summary string) string {
+	if index == m.selected {
+		return styles.SelectedRowStyle.Render("> "+name) + lipgloss.NewStyle().
+			Foreground(styles.GrayMedium).
+			Render("  "+summary)
+	}
+	return lipgloss.NewStyle().Foreground(styles.GrayLight).Render("  "+name) + lipgloss.NewStyle().
+		Foreground(styles.GrayDim).
+		Render("  "+summary)
+}
+
+// profileSummary lists what a profile presets, such as
+// "openrouter • 2 models • parallel • 5 samples • 2 tests".
+func profileSummary(profile config.Profile) string {
+	var parts []string
+	if profile.Provider != "" {
+		parts = append(parts, profile.Provider)
+	}
+	switch len(profile.Models) {
+	case 0:
+	case 1:
+		parts = append(parts, profile.Models[0])
+	default:
+		parts = append(parts, fmt.Sprintf("%d models", len(profile.Models)))
+	}
+	if profile.Mode != "" {
+		parts = append(parts, profile.Mode)
+	}
+	if profile.Samples > 0 {
+		parts = append(parts, fmt.Sprintf("%d samples", profile.Samples))
+	}
+	if len(profile.Tests) > 0 {
+		parts = append(parts, fmt.Sprintf("%d tests", len(profile.Tests)))
+	}
+	if profile.Context != "" {
+		parts = append(parts, "with context")
+	}
+	return strings.Join(parts, " • ")
+}
+
+// renderEnvWarnings lists the .env lines that could not be parsed, so a key
+// on such a line does not go missing without a word.
+func renderEnvWarnings(cfg *config.Config, width int) []string {
+	if cfg == nil || len(cfg.EnvWarnings) == 0 {
+		return nil
+	}
+	var lines []string
+	for _, warning := range cfg.EnvWarnings {
+		// The screens pad two columns on each side.
+		lines = append(lines, styles.WarningStyle.Width(max(20, width-4)).Render("⚠ "+warning+" (line ignored)"))
+	}
+	return append(lines, "")
+}
@@ -0,0 +1,186 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// DefaultJUnitThreshold is the pass@1 below which a test case fails. It
+// matches the score the results screen marks as [FAIL].
+const DefaultJUnitThreshold = 0.5
+
+// JUnitReport is a JUnit XML document with one suite per model and one case
+// per test category.
+type JUnitReport struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Time     float64      `xml:"time,attr"`
+	Suites   []JUnitSuite `xml:"testsuite"`
+}
+
+// JUnitSuite holds the test categories of one model.
+type JUnitSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Time       float64         `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Properties []JUnitProperty `xml:"properties>property,omitempty"`
+	Cases      []JUnitCase     `xml:"testcase"`
+}
+
+// JUnitProperty is a name/value pair attached to a suite.
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// JUnitCase is one test category of one model.
+type JUnitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// JUnitFailure explains why a test category scored below the threshold.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// BuildJUnit turns results entries into a JUnit report. A test case fails when
+// its pass@1 is below threshold, and the failure lists the errors of every
+// failed sample. duration may be nil; it reports how long a model spent on a
+// test when the run was timed.
+func BuildJUnit(entries []results.Entry, threshold float64, duration func(model, test string) time.Duration) JUnitReport {
+	report := JUnitReport{Name: "svelte-bench"}
+	suiteIndex := make(map[string]int)
+
+	for _, entry := range entries {
+		name := entry.ModelID
+		if entry.Provider != "" {
+			name = entry.Provider + "/" + entry.ModelID
+		}
+		index, ok := suiteIndex[name]
+		if !ok {
+			index = len(report.Suites)
+			suiteIndex[name] = index
+			suite := JUnitSuite{
+				Name: name,
+				Properties: []JUnitProperty{
+					{Name: "provider", Value: entry.Provider},
+					{Name: "model", Value: entry.ModelID},
+					{Name: "threshold", Value: fmt.Sprintf("%g", threshold)},
+				},
+			}
+			if !entry.Timestamp.IsZero() {
+				suite.Timestamp = entry.Timestamp.UTC().Format("2006-01-02T15:04:05")
+			}
+			report.Suites = append(report.Suites, suite)
+		}
+
+		testCase := JUnitCase{
+			Name:      entry.TestName,
+			Classname: name,
+			SystemOut: fmt.Sprintf("pass@1 %.1f%%, pass@10 %.1f%%, %d/%d samples correct",
+				entry.Pass1*100, entry.Pass10*100, entry.NumCorrect, entry.NumSamples),
+		}
+		if duration != nil {
+			testCase.Time = duration(entry.ModelID, entry.TestName).Round(time.Millisecond).Seconds()
+		}
+		if entry.Pass1 < threshold {
+			testCase.Failure = &JUnitFailure{
+				Message: fmt.Sprintf("pass@1 %.1f%% is below the %.1f%% threshold (%d/%d samples correct)",
+					entry.Pass1*100, threshold*100, entry.NumCorrect, entry.NumSamples),
+				Type: "BelowThreshold",
+				Text: sampleFailures(entry.Samples),
+			}
+		}
+
+		suite := &report.Suites[index]
+		suite.Cases = append(suite.Cases, testCase)
+		suite.Tests++
+		suite.Time += testCase.Time
+		if testCase.Failure != nil {
+			suite.Failures++
+		}
+	}
+
+	for _, suite := range report.Suites {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Time += suite.Time
+	}
+	return report
+}
+
+// sampleFailures lists the errors of each failed sample. Vitest colors its
+// assertion output, so escape sequences are stripped and repeated messages
+// from retries are listed once.
+func sampleFailures(samples []results.Sample) string {
+	var b strings.Builder
+	for _, sample := range samples {
+		if sample.Success {
+			continue
+		}
+		fmt.Fprintf(&b, "sample %d:\n", sample.Index)
+		if len(sample.Errors) == 0 {
+			b.WriteString("  (no error message recorded)\n")
+		}
+		seen := make(map[string]bool)
+		for _, message := range sample.Errors {
+			message = strings.TrimSpace(ansi.Strip(message))
+			if seen[message] {
+				continue
+			}
+			seen[message] = true
+			for _, line := range strings.Split(message, "\n") {
+				b.WriteString("  " + line + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// WriteJUnit writes the report as indented XML.
+func WriteJUnit(w io.Writer, report JUnitReport) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteJUnitFile writes the report to path, creating its directory.
+func WriteJUnitFile(path string, report JUnitReport) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteJUnit(file, report); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,68 @@
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func TestBuildJUnitFailsCasesBelowThreshold(t *testing.T) {
+	entries := []results.Entry{
+		{Provider: "OpenAI", ModelID: "gpt-5", TestName: "counter", Pass1: 0.9, NumSamples: 10, NumCorrect: 9},
+		{Provider: "OpenAI", ModelID: "gpt-5", TestName: "effect", Pass1: 0.2, NumSamples: 10, NumCorrect: 2, Samples: []results.Sample{
+			{Index: 0, Success: true},
+			{Index: 3, Errors: []string{"\x1b[31mexpected 2 to be 1\x1b[39m", "\x1b[31mexpected 2 to be 1\x1b[39m"}},
+			{Index: 4},
+		}},
+		{Provider: "Anthropic", ModelID: "claude", TestName: "counter", Pass1: 0.5, NumSamples: 10, NumCorrect: 5},
+	}
+	junit := BuildJUnit(entries, 0.5, func(model, test string) time.Duration {
+		if model == "gpt-5" {
+			return 1500 * time.Millisecond
+		}
+		return 0
+	})
+
+	if len(junit.Suites) != 2 || junit.Tests != 3 || junit.Failures != 1 || junit.Time != 3 {
+		t.Fatalf("unexpected totals: %d suites, %d tests, %d failures, %vs", len(junit.Suites), junit.Tests, junit.Failures, junit.Time)
+	}
+	gpt := junit.Suites[0]
+	if gpt.Name != "OpenAI/gpt-5" || gpt.Failures != 1 || gpt.Cases[0].Failure != nil {
+		t.Fatalf("unexpected suite %#v", gpt)
+	}
+	failure := gpt.Cases[1].Failure
+	if failure == nil || !strings.Contains(failure.Message, "20.0% is below the 50.0% threshold") {
+		t.Fatalf("expected effect to fail below the threshold, got %#v", failure)
+	}
+	want := "sample 3:\n  expected 2 to be 1\nsample 4:\n  (no error message recorded)\n"
+	if failure.Text != want {
+		t.Fatalf("expected de-duplicated, uncolored sample errors, got %q", failure.Text)
+	}
+	if junit.Suites[1].Cases[0].Failure != nil {
+		t.Fatal("a score equal to the threshold should pass")
+	}
+}
+
+func TestWriteJUnitProducesValidXML(t *testing.T) {
+	junit := BuildJUnit([]results.Entry{
+		{Provider: "OpenAI", ModelID: "gpt-5", TestName: "props", Pass1: 0, NumSamples: 1, Samples: []results.Sample{
+			{Index: 0, Errors: []string{"<Component> & \"quotes\""}},
+		}},
+	}, DefaultJUnitThreshold, nil)
+
+	var out bytes.Buffer
+	if err := WriteJUnit(&out, junit); err != nil {
+		t.Fatal(err)
+	}
+	var decoded JUnitReport
+	if err := xml.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("report is not valid XML: %v\n%s", err, out.String())
+	}
+	if decoded.Failures != 1 || !strings.Contains(decoded.Suites[0].Cases[0].Failure.Text, `<Component> & "quotes"`) {
+		t.Fatalf("unexpected decoded report %#v", decoded)
+	}
+}
@@ -0,0 +1,207 @@
+// Package report renders benchmark results into files for other tools:
+// summary tables for spreadsheets and PR comments.
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"svelte-bench/tui/internal/results"
+	"time"
+)
+
+// Format is an export file format.
+type Format string
+
+const (
+	FormatCSV      Format = "csv"
+	FormatMarkdown Format = "md"
+	FormatJSON     Format = "json"
+)
+
+// Formats lists the export formats in the order they are offered.
+var Formats = []Format{FormatCSV, FormatMarkdown, FormatJSON}
+
+// ParseFormat accepts a format name or file extension.
+func ParseFormat(value string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), ".")) {
+	case "csv":
+		return FormatCSV, nil
+	case "md", "markdown":
+		return FormatMarkdown, nil
+	case "json":
+		return FormatJSON, nil
+	}
+	return "", fmt.Errorf("unknown export format %q (want csv, md or json)", value)
+}
+
+// FormatForPath infers the format from the extension of path.
+func FormatForPath(path string) (Format, bool) {
+	format, err := ParseFormat(filepath.Ext(path))
+	return format, err == nil
+}
+
+// Label returns a human-readable name for the format.
+func (f Format) Label() string {
+	switch f {
+	case FormatMarkdown:
+		return "Markdown table"
+	case FormatJSON:
+		return "JSON"
+	}
+	return "CSV"
+}
+
+// Row is the summary of one model on one test category.
+type Row struct {
+	Provider  string        `json:"provider"`
+	Model     string        `json:"model"`
+	Test      string        `json:"test"`
+	PassAtOne float64       `json:"pass1"`
+	PassAtTen float64       `json:"pass10"`
+	Samples   int           `json:"samples"`
+	Correct   int           `json:"correct"`
+	Duration  time.Duration `json:"-"`
+}
+
+// Rows converts results entries into summary rows in file order. Results
+// files do not record durations; callers that timed the run fill them in.
+func Rows(entries []results.Entry) []Row {
+	rows := make([]Row, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, Row{
+			Provider:  entry.Provider,
+			Model:     entry.ModelID,
+			Test:      entry.TestName,
+			PassAtOne: entry.Pass1,
+			PassAtTen: entry.Pass10,
+			Samples:   entry.NumSamples,
+			Correct:   entry.NumCorrect,
+		})
+	}
+	return rows
+}
+
+// Write renders rows in format.
+func Write(w io.Writer, format Format, rows []Row) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, rows)
+	case FormatMarkdown:
+		return WriteMarkdown(w, rows)
+	case FormatJSON:
+		return WriteJSON(w, rows)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+// WriteFile renders rows into path, creating its directory.
+func WriteFile(path string, format Format, rows []Row) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(file, format, rows); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// DefaultPath returns where the TUI offers to save an export of the run
+// named name: an exports directory inside the benchmarks directory.
+func DefaultPath(benchmarksDir, name string, format Format) string {
+	name = strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+	name = strings.TrimPrefix(name, "benchmark-results-")
+	return filepath.Join(benchmarksDir, "exports", "summary-"+name+"."+string(format))
+}
+
+var csvHeader = []string{"provider", "model", "test", "pass1", "pass10", "samples", "correct", "duration_seconds"}
+
+// WriteCSV writes one line per row with a header. Durations are in seconds and
+// left empty when unknown.
+func WriteCSV(w io.Writer, rows []Row) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := []string{
+			row.Provider,
+			row.Model,
+			row.Test,
+			strconv.FormatFloat(row.PassAtOne, 'f', 4, 64),
+			strconv.FormatFloat(row.PassAtTen, 'f', 4, 64),
+			strconv.Itoa(row.Samples),
+			strconv.Itoa(row.Correct),
+			durationSeconds(row.Duration),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMarkdown writes a GitHub-flavoured Markdown table.
+func WriteMarkdown(w io.Writer, rows []Row) error {
+	var b strings.Builder
+	b.WriteString("| Provider | Model | Test | pass@1 | pass@10 | Correct | Samples | Duration |\n")
+	b.WriteString("| --- | --- | --- | ---: | ---: | ---: | ---: | ---: |\n")
+	for _, row := range rows {
+		duration := "–"
+		if row.Duration > 0 {
+			duration = row.Duration.Round(time.Second).String()
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %.1f%% | %.1f%% | %d | %d | %s |\n",
+			markdownCell(row.Provider), markdownCell(row.Model), markdownCell(row.Test),
+			row.PassAtOne*100, row.PassAtTen*100, row.Correct, row.Samples, duration)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", `\|`)
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
+type jsonRow struct {
+	Row
+	DurationSeconds *float64 `json:"durationSeconds"`
+}
+
+// WriteJSON writes the rows as a compact JSON array with durations in seconds,
+// null when unknown.
+func WriteJSON(w io.Writer, rows []Row) error {
+	out := make([]jsonRow, len(rows))
+	for i, row := range rows {
+		out[i] = jsonRow{Row: row}
+		if row.Duration > 0 {
+			seconds := row.Duration.Seconds()
+			out[i].DurationSeconds = &seconds
+		}
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func durationSeconds(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return strconv.FormatFloat(d.Seconds(), 'f', 1, 64)
+}
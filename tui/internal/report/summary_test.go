@@ -0,0 +1,81 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"svelte-bench/tui/internal/results"
+)
+
+func sampleRows() []Row {
+	rows := Rows([]results.Entry{
+		{Provider: "OpenAI", ModelID: "gpt-5", TestName: "counter", Pass1: 0.8, Pass10: 1, NumSamples: 10, NumCorrect: 8},
+		{Provider: "OpenAI", ModelID: "gpt|5", TestName: "effect", Pass1: 0.25, Pass10: 0.9, NumSamples: 4, NumCorrect: 1},
+	})
+	rows[0].Duration = 90 * time.Second
+	return rows
+}
+
+func TestWriteCSVLeavesUnknownDurationsEmpty(t *testing.T) {
+	var out bytes.Buffer
+	if err := Write(&out, FormatCSV, sampleRows()); err != nil {
+		t.Fatal(err)
+	}
+	want := "provider,model,test,pass1,pass10,samples,correct,duration_seconds\n" +
+		"OpenAI,gpt-5,counter,0.8000,1.0000,10,8,90.0\n" +
+		"OpenAI,gpt|5,effect,0.2500,0.9000,4,1,\n"
+	if out.String() != want {
+		t.Fatalf("unexpected CSV:\n%s", out.String())
+	}
+}
+
+func TestWriteMarkdownEscapesCells(t *testing.T) {
+	var out bytes.Buffer
+	if err := Write(&out, FormatMarkdown, sampleRows()); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header, separator and two rows, got:\n%s", out.String())
+	}
+	if lines[2] != "| OpenAI | gpt-5 | counter | 80.0% | 100.0% | 8 | 10 | 1m30s |" {
+		t.Fatalf("unexpected row %q", lines[2])
+	}
+	if !strings.Contains(lines[3], `gpt\|5`) {
+		t.Fatalf("expected pipe in model name to be escaped, got %q", lines[3])
+	}
+}
+
+func TestWriteJSONIsCompact(t *testing.T) {
+	var out bytes.Buffer
+	if err := Write(&out, FormatJSON, sampleRows()); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(out.String(), "\n") != 1 {
+		t.Fatalf("expected a single line of JSON, got:\n%s", out.String())
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded[0]["durationSeconds"] != 90.0 || decoded[1]["durationSeconds"] != nil || decoded[1]["correct"] != 1.0 {
+		t.Fatalf("unexpected JSON rows %#v", decoded)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for input, want := range map[string]Format{"CSV": FormatCSV, ".md": FormatMarkdown, "markdown": FormatMarkdown, "json": FormatJSON} {
+		if got, err := ParseFormat(input); err != nil || got != want {
+			t.Fatalf("ParseFormat(%q) = %q, %v", input, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Fatal("expected unknown format to be rejected")
+	}
+	if format, ok := FormatForPath("out/summary.md"); !ok || format != FormatMarkdown {
+		t.Fatalf("expected Markdown from extension, got %q", format)
+	}
+}
@@ -0,0 +1,143 @@
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Decoder streams entries out of a results file one at a time so a
+// multi-megabyte file never has to be held in memory as a whole.
+type Decoder struct {
+	path     string
+	layout   Layout
+	fileTime time.Time
+	dec      *json.Decoder
+	index    int
+	started  bool
+	done     bool
+}
+
+// NewDecoder reads a results array from r. The path is only used to detect
+// the layout and to label errors.
+func NewDecoder(r io.Reader, path string) *Decoder {
+	return &Decoder{
+		path:     path,
+		layout:   DetectLayout(path),
+		fileTime: FileTime(path),
+		dec:      json.NewDecoder(r),
+	}
+}
+
+// Layout returns the layout the decoder detected for its file.
+func (d *Decoder) Layout() Layout {
+	return d.layout
+}
+
+// Next returns the next valid entry, or io.EOF after the last one. A
+// malformed entry is returned as an *EntryError; decoding can continue after
+// it. Any other error means the file itself is unreadable.
+func (d *Decoder) Next() (Entry, error) {
+	if d.done {
+		return Entry{}, io.EOF
+	}
+	if !d.started {
+		d.started = true
+		token, err := d.dec.Token()
+		if err != nil {
+			d.done = true
+			return Entry{}, d.fileError(err)
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			d.done = true
+			return Entry{}, fmt.Errorf("%s: expected a JSON array of results", filepath.Base(d.path))
+		}
+	}
+
+	if !d.dec.More() {
+		d.done = true
+		if _, err := d.dec.Token(); err != nil {
+			return Entry{}, d.fileError(err)
+		}
+		return Entry{}, io.EOF
+	}
+
+	index := d.index
+	d.index++
+
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		d.done = true
+		return Entry{}, d.fileError(err)
+	}
+
+	entry, err := decodeEntry(raw)
+	if err != nil {
+		return Entry{}, &EntryError{Path: d.path, Index: index, Err: err}
+	}
+	if entry.Timestamp.IsZero() {
+		// v1 files and some hand-merged files carry no per-entry timestamp.
+		entry.Timestamp = d.fileTime
+	}
+	return entry, nil
+}
+
+func (d *Decoder) fileError(err error) error {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("%s: %w", filepath.Base(d.path), err)
+}
+
+// Stream calls fn for every valid entry in the file at path and returns the
+// malformed entries it skipped. An error from fn stops the stream.
+func Stream(path string, fn func(Entry) error) ([]*EntryError, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var issues []*EntryError
+	decoder := NewDecoder(bufio.NewReader(file), path)
+	for {
+		entry, err := decoder.Next()
+		if errors.Is(err, io.EOF) {
+			return issues, nil
+		}
+		var entryErr *EntryError
+		if errors.As(err, &entryErr) {
+			issues = append(issues, entryErr)
+			continue
+		}
+		if err != nil {
+			return issues, err
+		}
+		if err := fn(entry); err != nil {
+			return issues, err
+		}
+	}
+}
+
+// Load reads every entry of the results file at path.
+func Load(path string) (*File, error) {
+	file := &File{
+		Path:   path,
+		Layout: DetectLayout(path),
+		Date:   FileTime(path),
+	}
+	issues, err := Stream(path, func(entry Entry) error {
+		file.Entries = append(file.Entries, entry)
+		return nil
+	})
+	file.Issues = issues
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
@@ -0,0 +1,161 @@
+package results
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const validEntry = `{"testName":"counter","provider":"OpenAI","modelId":"gpt-4o","numSamples":2,"numCorrect":1,"pass1":0.5,"pass10":1,
+	"context":{"used":false,"content":""},"timestamp":"2025-10-17T19:39:14.181Z",
+	"samples":[{"index":0,"code":"<p>ok</p>","success":true,"errors":[],"temperature":0},
+	           {"index":1,"code":"<p>","success":false,"errors":["Expected 1 to be 2"]}]}`
+
+func writeFixture(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadParsesTypedEntries(t *testing.T) {
+	path := writeFixture(t, t.TempDir(), "benchmark-results-2025-10-17T19-39-14.181Z.json", "["+validEntry+"]")
+
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if file.Layout != LayoutCurrent || len(file.Entries) != 1 || len(file.Issues) != 0 {
+		t.Fatalf("unexpected file: %#v", file)
+	}
+
+	entry := file.Entries[0]
+	if entry.ModelID != "gpt-4o" || entry.NumCorrect != 1 || entry.Pass1 != 0.5 || len(entry.Samples) != 2 {
+		t.Fatalf("unexpected entry: %#v", entry)
+	}
+	if entry.Samples[0].Temperature == nil || entry.Samples[1].Temperature != nil {
+		t.Fatal("expected the optional temperature to be preserved only where present")
+	}
+	if entry.Samples[1].Errors[0] != "Expected 1 to be 2" {
+		t.Fatalf("unexpected sample errors: %#v", entry.Samples[1].Errors)
+	}
+}
+
+func TestLoadReadsV1LayoutWithFileTimestamp(t *testing.T) {
+	dir := t.TempDir()
+	v1Entry := strings.Replace(validEntry, `"timestamp":"2025-10-17T19:39:14.181Z",`, "", 1)
+	path := writeFixture(t, dir, "v1/v1-benchmark-results-2025-05-03T19-47-53.331Z.json", "["+v1Entry+"]")
+
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if file.Layout != LayoutV1 {
+		t.Fatalf("expected v1 layout, got %v", file.Layout)
+	}
+	want := time.Date(2025, time.May, 3, 19, 47, 53, 331000000, time.UTC)
+	if !file.Entries[0].Timestamp.Equal(want) {
+		t.Fatalf("expected v1 entry timestamp from file name %s, got %s", want, file.Entries[0].Timestamp)
+	}
+
+	files, err := ListFiles(dir, true)
+	if err != nil || len(files) != 1 || files[0] != path {
+		t.Fatalf("expected v1 file to be listed, got %v (%v)", files, err)
+	}
+	if files, _ := ListFiles(dir, false); len(files) != 0 {
+		t.Fatalf("expected v1 files to be opt-in, got %v", files)
+	}
+}
+
+func TestStreamReportsMalformedEntriesAndContinues(t *testing.T) {
+	missingModel := strings.Replace(validEntry, `"modelId":"gpt-4o",`, "", 1)
+	badSample := strings.Replace(validEntry, `"index":1,`, "", 1)
+	badRange := strings.Replace(validEntry, `"numCorrect":1`, `"numCorrect":3`, 1)
+	path := writeFixture(t, t.TempDir(), "benchmark-results-x.json",
+		"["+validEntry+","+missingModel+","+badSample+",null,"+badRange+`,"text",`+validEntry+"]")
+
+	count := 0
+	issues, err := Stream(path, func(Entry) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Stream returned error: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected the two valid entries, got %d", count)
+	}
+	if len(issues) != 5 {
+		t.Fatalf("expected five malformed entries, got %v", issues)
+	}
+
+	wantIndexes := []int{1, 2, 3, 4, 5}
+	for i, issue := range issues {
+		if issue.Index != wantIndexes[i] {
+			t.Errorf("issue %d: expected index %d, got %d", i, wantIndexes[i], issue.Index)
+		}
+	}
+	if got := issues[0].Error(); got != "benchmark-results-x.json[1]: missing required field(s) modelId" {
+		t.Fatalf("unexpected issue message %q", got)
+	}
+	if !strings.Contains(issues[1].Error(), "samples[1]: missing required field(s) index") {
+		t.Fatalf("unexpected sample issue %q", issues[1].Error())
+	}
+}
+
+func TestDecoderRejectsTruncatedAndNonArrayFiles(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader("["+validEntry+`,{"testName":`), "benchmark-results-cut.json")
+	if _, err := decoder.Next(); err != nil {
+		t.Fatalf("expected the first entry before the truncation, got %v", err)
+	}
+	if _, err := decoder.Next(); err == nil || errors.Is(err, io.EOF) {
+		t.Fatalf("expected truncated file error, got %v", err)
+	}
+	if _, err := decoder.Next(); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected decoding to stop after a file error, got %v", err)
+	}
+
+	decoder = NewDecoder(strings.NewReader(`{"testName":"counter"}`), "benchmark-results-object.json")
+	if _, err := decoder.Next(); err == nil || !strings.Contains(err.Error(), "expected a JSON array") {
+		t.Fatalf("expected non-array error, got %v", err)
+	}
+}
+
+func TestIsResultsFile(t *testing.T) {
+	for name, want := range map[string]bool{
+		"benchmark-results-2025-10-17T19-39-14.181Z.json":              true,
+		"benchmark-results-with-context-2025-10-17T19-39-14.181Z.json": true,
+		"v1-benchmark-results-2025-05-03T19-47-53.331Z.json":           true,
+		"benchmark-results-merged.json":                                false,
+		"benchmark-results-merged.html":                                false,
+		"benchmark-results-2025-10-17T19-39-14.181Z.manifest.json":     false,
+		"notes.json": false,
+	} {
+		if got := IsResultsFile(name); got != want {
+			t.Errorf("IsResultsFile(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestFileTag(t *testing.T) {
+	for name, want := range map[string]string{
+		"benchmark-results-2025-10-17T19-39-14.181Z.json":                        "",
+		"benchmark-results-with-context-2025-10-17T19-39-14.181Z.json":           "",
+		"benchmark-results-nightly-2025-10-17T19-39-14.181Z.json":                "nightly",
+		"benchmark-results-with-context-debug-run-2025-10-17T19-39-14.181Z.json": "debug-run",
+		"v1-benchmark-results-2025-05-03T19-47-53.331Z.json":                     "",
+	} {
+		if got := FileTag(name); got != want {
+			t.Errorf("FileTag(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
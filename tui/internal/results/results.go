@@ -0,0 +1,197 @@
+// Package results reads the benchmark-results JSON files written by the
+// TypeScript runner, including the legacy files kept in benchmarks/v1.
+package results
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Layout identifies the generation of a results file.
+type Layout int
+
+const (
+	// LayoutCurrent is a benchmark-results-*.json file in benchmarks/.
+	LayoutCurrent Layout = iota
+	// LayoutV1 is a v1-benchmark-results-*.json file from benchmarks/v1. The
+	// entries have the same shape but usually lack a timestamp and were scored
+	// against the first version of the test suite.
+	LayoutV1
+)
+
+func (l Layout) String() string {
+	if l == LayoutV1 {
+		return "v1"
+	}
+	return "current"
+}
+
+// Context records the context file a run was prompted with.
+type Context struct {
+	Used     bool   `json:"used"`
+	Filename string `json:"filename,omitempty"`
+	Content  string `json:"content"`
+}
+
+// Sample is one generated component and its test outcome.
+type Sample struct {
+	Index       int      `json:"index"`
+	Code        string   `json:"code"`
+	Success     bool     `json:"success"`
+	Errors      []string `json:"errors"`
+	Temperature *float64 `json:"temperature,omitempty"`
+}
+
+// Entry is the result of one test category for one model.
+type Entry struct {
+	TestName   string    `json:"testName"`
+	Provider   string    `json:"provider"`
+	ModelID    string    `json:"modelId"`
+	NumSamples int       `json:"numSamples"`
+	NumCorrect int       `json:"numCorrect"`
+	Pass1      float64   `json:"pass1"`
+	Pass10     float64   `json:"pass10"`
+	Context    Context   `json:"context"`
+	Samples    []Sample  `json:"samples"`
+	Version    string    `json:"version,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// File is a fully loaded results file. Malformed entries are left out of
+// Entries and reported in Issues.
+type File struct {
+	Path    string
+	Layout  Layout
+	Date    time.Time
+	Entries []Entry
+	Issues  []*EntryError
+}
+
+// Name returns the base name of the file.
+func (f *File) Name() string {
+	return filepath.Base(f.Path)
+}
+
+// EntryError reports a malformed entry by file and array index.
+type EntryError struct {
+	Path  string
+	Index int
+	Err   error
+}
+
+func (e *EntryError) Error() string {
+	return fmt.Sprintf("%s[%d]: %v", filepath.Base(e.Path), e.Index, e.Err)
+}
+
+func (e *EntryError) Unwrap() error {
+	return e.Err
+}
+
+const (
+	mergedFileName = "benchmark-results-merged.json"
+	v1Dir          = "v1"
+	v1Prefix       = "v1-"
+)
+
+// ManifestSuffix replaces the .json extension of a results file to name its
+// manifest.
+const ManifestSuffix = ".manifest.json"
+
+// ManifestDir is the subdirectory of the benchmarks directory holding run
+// manifests. The TypeScript build reads every .json file at the top level of
+// benchmarks/ as results, so nothing else may be written there.
+const ManifestDir = "manifests"
+
+var fileTimestampPattern = regexp.MustCompile(`(\d{4}-\d{2}-\d{2})T(\d{2})-(\d{2})-(\d{2})\.(\d{3})Z`)
+
+// IsResultsFile reports whether name is a stored run rather than the merged
+// file, a run manifest or another artifact in the benchmarks directory.
+func IsResultsFile(name string) bool {
+	name = strings.TrimPrefix(name, v1Prefix)
+	return strings.HasPrefix(name, "benchmark-results-") &&
+		strings.HasSuffix(name, ".json") &&
+		!strings.HasSuffix(name, ManifestSuffix) &&
+		name != mergedFileName
+}
+
+// FileTag returns the custom prefix the runner put in a results file name,
+// such as "nightly" in benchmark-results-nightly-2025-10-17T19-39-14.181Z.json,
+// or "" when the run was not tagged.
+func FileTag(path string) string {
+	name := strings.TrimPrefix(filepath.Base(path), v1Prefix)
+	name = strings.TrimPrefix(name, "benchmark-results-")
+	name = strings.TrimPrefix(name, "with-context-")
+	location := fileTimestampPattern.FindStringIndex(name)
+	if location == nil {
+		return ""
+	}
+	return strings.TrimSuffix(name[:location[0]], "-")
+}
+
+// DetectLayout infers the layout of a results file from its path.
+func DetectLayout(path string) Layout {
+	if strings.HasPrefix(filepath.Base(path), v1Prefix) || filepath.Base(filepath.Dir(path)) == v1Dir {
+		return LayoutV1
+	}
+	return LayoutCurrent
+}
+
+// FileTime parses the ISO timestamp embedded in results file names, falling
+// back to the file modification time.
+func FileTime(path string) time.Time {
+	if match := fileTimestampPattern.FindStringSubmatch(filepath.Base(path)); match != nil {
+		value := fmt.Sprintf("%sT%s:%s:%s.%sZ", match[1], match[2], match[3], match[4], match[5])
+		if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return parsed
+		}
+	}
+	if info, err := os.Stat(path); err == nil {
+		return info.ModTime().UTC()
+	}
+	return time.Time{}
+}
+
+// ListFiles returns the results files in dir, oldest first. When includeV1 is
+// set, legacy files from dir/v1 are listed ahead of the current ones.
+func ListFiles(dir string, includeV1 bool) ([]string, error) {
+	files, err := listDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if includeV1 {
+		legacy, err := listDir(filepath.Join(dir, v1Dir))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		files = append(legacy, files...)
+	}
+	return files, nil
+}
+
+func listDir(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !IsResultsFile(entry.Name()) {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		left, right := FileTime(files[i]), FileTime(files[j])
+		if !left.Equal(right) {
+			return left.Before(right)
+		}
+		return files[i] < files[j]
+	})
+	return files, nil
+}
@@ -0,0 +1,85 @@
+package results
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var requiredEntryFields = []string{
+	"testName", "provider", "modelId", "numSamples", "numCorrect", "pass1", "pass10", "samples",
+}
+
+var requiredSampleFields = []string{"index", "code", "success", "errors"}
+
+// decodeEntry checks that raw has the documented shape before decoding it, so
+// a missing field is reported instead of silently becoming a zero value.
+func decodeEntry(raw json.RawMessage) (Entry, error) {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return Entry{}, fmt.Errorf("entry is null")
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return Entry{}, fmt.Errorf("entry is not an object: %w", err)
+	}
+	if missing := missingFields(fields, requiredEntryFields); len(missing) > 0 {
+		return Entry{}, fmt.Errorf("missing required field(s) %s", strings.Join(missing, ", "))
+	}
+
+	var samples []map[string]json.RawMessage
+	if err := json.Unmarshal(fields["samples"], &samples); err != nil {
+		return Entry{}, fmt.Errorf("samples: %w", err)
+	}
+	for i, sample := range samples {
+		if missing := missingFields(sample, requiredSampleFields); len(missing) > 0 {
+			return Entry{}, fmt.Errorf("samples[%d]: missing required field(s) %s", i, strings.Join(missing, ", "))
+		}
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return Entry{}, err
+	}
+	if err := entry.Validate(); err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+func missingFields(fields map[string]json.RawMessage, required []string) []string {
+	var missing []string
+	for _, name := range required {
+		value, ok := fields[name]
+		if !ok || bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// Validate checks the value ranges of an entry. Cross-field consistency, such
+// as numCorrect against the samples, is not checked here.
+func (e Entry) Validate() error {
+	switch {
+	case strings.TrimSpace(e.TestName) == "":
+		return fmt.Errorf("testName is empty")
+	case strings.TrimSpace(e.ModelID) == "":
+		return fmt.Errorf("modelId is empty")
+	case e.NumSamples < 0:
+		return fmt.Errorf("numSamples is negative")
+	case e.NumCorrect < 0 || e.NumCorrect > e.NumSamples:
+		return fmt.Errorf("numCorrect %d is outside 0..%d", e.NumCorrect, e.NumSamples)
+	case !isProbability(e.Pass1):
+		return fmt.Errorf("pass1 %v is outside 0..1", e.Pass1)
+	case !isProbability(e.Pass10):
+		return fmt.Errorf("pass10 %v is outside 0..1", e.Pass10)
+	}
+	return nil
+}
+
+func isProbability(value float64) bool {
+	return !math.IsNaN(value) && value >= 0 && value <= 1
+}
@@ -0,0 +1,248 @@
+package results
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"svelte-bench/tui/internal/stats"
+)
+
+// estimatorTolerance absorbs float formatting differences between the stored
+// pass@k values and the recomputed ones.
+const estimatorTolerance = 1e-9
+
+// Severity tells problems that break consumers of a results file from ones
+// the runner produces on purpose.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Problem is one finding of VerifyFile, located by a JSON path such as
+// $[3].samples[2].index.
+type Problem struct {
+	File     string
+	JSONPath string
+	Severity Severity
+	Message  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s: %s", filepath.Base(p.File), p.JSONPath, p.Severity, p.Message)
+}
+
+// VerifyFile checks the results file at path against the schema and for
+// internal consistency: numCorrect against the successful samples, pass1 and
+// pass10 against the estimator the runner uses, sample indices and duplicate
+// tests per model. Unlike Load it reports every problem instead of skipping
+// malformed entries. The error is only set when the file cannot be read.
+func VerifyFile(path string) ([]Problem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Verify(path, data), nil
+}
+
+// Verify checks data read from the results file at path; see VerifyFile.
+func Verify(path string, data []byte) []Problem {
+	verifier := &verifier{path: path}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+		switch {
+		case len(bytes.TrimSpace(data)) == 0:
+			verifier.errorf("$", "file is empty")
+		case errors.As(err, &syntaxErr):
+			verifier.errorf("$", "invalid JSON at byte %d: %v", syntaxErr.Offset, err)
+		default:
+			verifier.errorf("$", "expected a JSON array of results")
+		}
+		return verifier.problems
+	}
+
+	seen := make(map[string]int)
+	for i, item := range raw {
+		at := fmt.Sprintf("$[%d]", i)
+		entry, ok := verifier.entry(at, item)
+		if !ok {
+			continue
+		}
+		key := entry.Provider + "\x00" + entry.ModelID + "\x00" + entry.TestName
+		if first, duplicate := seen[key]; duplicate {
+			verifier.errorf(at+".testName", "duplicate test %q for %s, first at $[%d]", entry.TestName, entry.ModelID, first)
+		} else {
+			seen[key] = i
+		}
+	}
+	return verifier.problems
+}
+
+type verifier struct {
+	path     string
+	problems []Problem
+}
+
+func (v *verifier) report(severity Severity, at, format string, args ...any) {
+	v.problems = append(v.problems, Problem{
+		File:     v.path,
+		JSONPath: at,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *verifier) errorf(at, format string, args ...any) {
+	v.report(SeverityError, at, format, args...)
+}
+
+func (v *verifier) warnf(at, format string, args ...any) {
+	v.report(SeverityWarning, at, format, args...)
+}
+
+// entry checks one array item at path at. ok is false when the item is too
+// broken to check further.
+func (v *verifier) entry(at string, raw json.RawMessage) (Entry, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		v.errorf(at, "entry is not an object")
+		return Entry{}, false
+	}
+	missing := missingFields(fields, requiredEntryFields)
+	for _, name := range missing {
+		v.errorf(at+"."+name, "missing required field")
+	}
+
+	var rawSamples []map[string]json.RawMessage
+	if value, ok := fields["samples"]; ok {
+		if err := json.Unmarshal(value, &rawSamples); err != nil {
+			v.errorf(at+".samples", "expected an array of sample objects")
+			return Entry{}, false
+		}
+	}
+	for j, sample := range rawSamples {
+		if sample == nil {
+			v.errorf(fmt.Sprintf("%s.samples[%d]", at, j), "sample is not an object")
+			missing = append(missing, "samples")
+			continue
+		}
+		for _, name := range missingFields(sample, requiredSampleFields) {
+			v.errorf(fmt.Sprintf("%s.samples[%d].%s", at, j, name), "missing required field")
+			missing = append(missing, "samples")
+		}
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			v.errorf(at+"."+typeErr.Field, "expected %s, got JSON %s", typeErr.Type, typeErr.Value)
+		} else {
+			v.errorf(at, "%v", err)
+		}
+		return Entry{}, false
+	}
+	if len(missing) > 0 {
+		// Consistency checks would repeat the missing fields as zero values.
+		return entry, true
+	}
+	if err := entry.Validate(); err != nil {
+		v.errorf(at, "%v", err)
+		return entry, true
+	}
+
+	v.counts(at, entry)
+	v.indices(at, entry.Samples)
+	return entry, true
+}
+
+// counts checks the stored counts and pass@k values against the samples and
+// the estimator. The runner computes pass10 with k = min(10, numSamples).
+func (v *verifier) counts(at string, entry Entry) {
+	if len(entry.Samples) != entry.NumSamples {
+		v.errorf(at+".numSamples", "numSamples is %d but %d samples are stored", entry.NumSamples, len(entry.Samples))
+	}
+	correct := 0
+	for _, sample := range entry.Samples {
+		if sample.Success {
+			correct++
+		}
+	}
+	if correct != entry.NumCorrect {
+		v.errorf(at+".numCorrect", "numCorrect is %d but %d samples succeeded", entry.NumCorrect, correct)
+	}
+
+	n, c := entry.NumSamples, entry.NumCorrect
+	pass1, pass10 := 0.0, 0.0
+	if n > 0 {
+		pass1 = stats.PassAtK(n, c, 1)
+		pass10 = stats.PassAtK(n, c, min(10, n))
+	}
+	if math.Abs(entry.Pass1-pass1) > estimatorTolerance {
+		v.errorf(at+".pass1", "pass1 is %v but the estimator gives %v for %d/%d", entry.Pass1, pass1, c, n)
+	}
+	if math.Abs(entry.Pass10-pass10) > estimatorTolerance {
+		v.errorf(at+".pass10", "pass10 is %v but the estimator gives %v for %d/%d", entry.Pass10, pass10, c, n)
+	}
+}
+
+// indices checks that sample indices are unique and non-negative. Gaps are
+// only warnings: the runner drops samples whose generation failed and keeps
+// the original index of the others.
+func (v *verifier) indices(at string, samples []Sample) {
+	seen := make(map[int]int, len(samples))
+	var indices []int
+	for j, sample := range samples {
+		path := fmt.Sprintf("%s.samples[%d].index", at, j)
+		switch first, duplicate := seen[sample.Index]; {
+		case sample.Index < 0:
+			v.errorf(path, "index %d is negative", sample.Index)
+		case duplicate:
+			v.errorf(path, "index %d repeats samples[%d]", sample.Index, first)
+		default:
+			seen[sample.Index] = j
+			indices = append(indices, sample.Index)
+		}
+	}
+
+	sort.Ints(indices)
+	var gaps []string
+	next := 0
+	for _, index := range indices {
+		for ; next < index; next++ {
+			gaps = append(gaps, fmt.Sprint(next))
+		}
+		next = index + 1
+	}
+	if len(gaps) > 0 {
+		v.warnf(at+".samples", "sample indices are not contiguous: missing %s", strings.Join(gaps, ", "))
+	}
+}
+
+// CountProblems returns the number of errors and warnings in problems.
+func CountProblems(problems []Problem) (errorCount, warningCount int) {
+	for _, problem := range problems {
+		if problem.Severity == SeverityWarning {
+			warningCount++
+		} else {
+			errorCount++
+		}
+	}
+	return errorCount, warningCount
+}
@@ -0,0 +1,82 @@
+package results
+
+import (
+	"strings"
+	"testing"
+)
+
+func problemAt(problems []Problem, jsonPath string) (Problem, bool) {
+	for _, problem := range problems {
+		if problem.JSONPath == jsonPath {
+			return problem, true
+		}
+	}
+	return Problem{}, false
+}
+
+func TestVerifyAcceptsConsistentFile(t *testing.T) {
+	if problems := Verify("ok.json", []byte("["+validEntry+"]")); len(problems) != 0 {
+		t.Fatalf("expected no problems, got %v", problems)
+	}
+}
+
+func TestVerifyReportsInconsistentEntries(t *testing.T) {
+	wrongCounts := strings.Replace(validEntry, `"numCorrect":1,"pass1":0.5`, `"numCorrect":2,"pass1":0.9`, 1)
+	duplicate := strings.Replace(validEntry, `"index":1`, `"index":0`, 1)
+	missing := `{"testName":"effect","provider":"OpenAI","modelId":"gpt-4o","numSamples":1,"numCorrect":0,"pass1":0,"pass10":0,
+		"samples":[{"index":0,"success":false,"errors":[]}]}`
+	data := "[" + validEntry + "," + wrongCounts + "," + duplicate + "," + missing + "]"
+
+	problems := Verify("bad.json", []byte(data))
+	for _, want := range []struct {
+		path    string
+		message string
+	}{
+		{"$[1].testName", "duplicate test \"counter\" for gpt-4o, first at $[0]"},
+		{"$[1].numCorrect", "numCorrect is 2 but 1 samples succeeded"},
+		{"$[1].pass1", "pass1 is 0.9 but the estimator gives 1 for 2/2"},
+		{"$[2].samples[1].index", "index 0 repeats samples[0]"},
+		{"$[3].samples[0].code", "missing required field"},
+	} {
+		problem, ok := problemAt(problems, want.path)
+		if !ok {
+			t.Fatalf("expected a problem at %s, got %v", want.path, problems)
+		}
+		if problem.Message != want.message || problem.Severity != SeverityError {
+			t.Errorf("%s: got %s %q, want error %q", want.path, problem.Severity, problem.Message, want.message)
+		}
+	}
+	if _, ok := problemAt(problems, "$[3].numCorrect"); ok {
+		t.Fatal("expected no consistency checks on an entry with missing fields")
+	}
+	if got := problems[0].String(); !strings.HasPrefix(got, "bad.json: $[") {
+		t.Fatalf("expected problems prefixed with file and JSON path, got %q", got)
+	}
+}
+
+func TestVerifyWarnsAboutIndexGaps(t *testing.T) {
+	gap := strings.Replace(validEntry, `"index":1`, `"index":3`, 1)
+	problems := Verify("gap.json", []byte("["+gap+"]"))
+	if len(problems) != 1 || problems[0].Severity != SeverityWarning ||
+		problems[0].Message != "sample indices are not contiguous: missing 1, 2" {
+		t.Fatalf("expected one gap warning, got %v", problems)
+	}
+	if errorCount, warningCount := CountProblems(problems); errorCount != 0 || warningCount != 1 {
+		t.Fatalf("expected 0 errors and 1 warning, got %d and %d", errorCount, warningCount)
+	}
+}
+
+func TestVerifyReportsUnreadableFiles(t *testing.T) {
+	for data, want := range map[string]string{
+		`[` + validEntry:      "invalid JSON",
+		`{"testName":"x"}`:    "expected a JSON array of results",
+		"  ":                  "file is empty",
+		`[null, 1]`:           "entry is not an object",
+		`[{"samples":"bad"}]`: "expected an array of sample objects",
+	} {
+		problems := Verify("broken.json", []byte(data))
+		if len(problems) == 0 || !strings.Contains(problems[len(problems)-1].Message, want) {
+			t.Errorf("Verify(%.20q): expected %q, got %v", data, want, problems)
+		}
+	}
+}
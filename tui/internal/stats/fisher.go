@@ -0,0 +1,53 @@
+// Package stats holds the small amount of statistics the TUI needs to tell a
+// real change in pass rates from sampling noise.
+package stats
+
+import "math"
+
+// SignificanceLevel is the two-sided p-value below which a difference between
+// two pass rates is reported as significant.
+const SignificanceLevel = 0.05
+
+// FisherExact returns the two-sided p-value of Fisher's exact test for the
+// 2x2 table of passes and failures in two groups. It is exact for the small
+// sample counts benchmarks use, where normal approximations are unreliable.
+func FisherExact(passA, totalA, passB, totalB int) float64 {
+	if totalA <= 0 || totalB <= 0 {
+		return 1
+	}
+	passes := passA + passB
+	total := totalA + totalB
+
+	observed := logHypergeometric(passA, totalA, passes, total)
+	low := max(0, passes-totalB)
+	high := min(passes, totalA)
+
+	p := 0.0
+	for k := low; k <= high; k++ {
+		probability := logHypergeometric(k, totalA, passes, total)
+		// Tables at least as extreme as the observed one are those that are no
+		// more likely; the tolerance absorbs floating-point noise in ties.
+		if probability <= observed+1e-7 {
+			p += math.Exp(probability)
+		}
+	}
+	return math.Min(1, p)
+}
+
+// logHypergeometric is log P(X = k) for k passes in a group of n drawn from a
+// population of total with passes successes.
+func logHypergeometric(k, n, passes, total int) float64 {
+	return logChoose(passes, k) + logChoose(total-passes, n-k) - logChoose(total, n)
+}
+
+func logChoose(n, k int) float64 {
+	if k < 0 || k > n {
+		return math.Inf(-1)
+	}
+	return logFactorial(n) - logFactorial(k) - logFactorial(n-k)
+}
+
+func logFactorial(n int) float64 {
+	value, _ := math.Lgamma(float64(n) + 1)
+	return value
+}
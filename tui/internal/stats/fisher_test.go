@@ -0,0 +1,32 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFisherExactMatchesReferenceValues(t *testing.T) {
+	// Reference values from scipy.stats.fisher_exact(..., alternative="two-sided").
+	cases := []struct {
+		passA, totalA, passB, totalB int
+		want                         float64
+	}{
+		{7, 10, 8, 10, 1.0},
+		{10, 10, 2, 10, 0.000714},
+		{9, 10, 3, 10, 0.019769},
+		{3, 10, 3, 10, 1.0},
+		{0, 1, 1, 1, 1.0},
+	}
+	for _, tc := range cases {
+		got := FisherExact(tc.passA, tc.totalA, tc.passB, tc.totalB)
+		if math.Abs(got-tc.want) > 1e-5 {
+			t.Errorf("FisherExact(%d/%d vs %d/%d) = %.6f, want %.6f", tc.passA, tc.totalA, tc.passB, tc.totalB, got, tc.want)
+		}
+	}
+}
+
+func TestFisherExactHandlesEmptyGroups(t *testing.T) {
+	if got := FisherExact(0, 0, 5, 10); got != 1 {
+		t.Fatalf("expected an empty group to be inconclusive, got %v", got)
+	}
+}
@@ -0,0 +1,122 @@
+package stats
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Z95 is the standard normal quantile of a two-sided 95% interval.
+const Z95 = 1.959963984540054
+
+// BootstrapIterations is the number of resamples used for bootstrap intervals.
+const BootstrapIterations = 2000
+
+// bootstrapSeed makes bootstrap intervals reproducible, so the same results
+// file always shows the same interval.
+const bootstrapSeed = 0x5eed
+
+// Interval is a confidence interval for a pass rate.
+type Interval struct {
+	Low  float64
+	High float64
+}
+
+// Contains reports whether value lies within the interval.
+func (i Interval) Contains(value float64) bool {
+	return value >= i.Low && value <= i.High
+}
+
+// Wilson returns the Wilson score interval for correct passes out of total
+// samples. Unlike the normal approximation it stays inside 0..1 and is usable
+// at 0% and 100%, which are common with ten samples.
+func Wilson(correct, total int, z float64) Interval {
+	if total <= 0 {
+		return Interval{Low: 0, High: 1}
+	}
+	n := float64(total)
+	p := float64(correct) / n
+	z2 := z * z
+	denominator := 1 + z2/n
+	center := (p + z2/(2*n)) / denominator
+	half := z * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / denominator
+	return Interval{Low: math.Max(0, center-half), High: math.Min(1, center+half)}
+}
+
+// Proportion is the number of correct samples out of the samples run.
+type Proportion struct {
+	Correct int
+	Total   int
+}
+
+// Rate returns the pass rate, or 0 without samples.
+func (p Proportion) Rate() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Correct) / float64(p.Total)
+}
+
+// MeanRate returns the unweighted mean pass rate of groups, the overall score
+// the TUI reports across test categories.
+func MeanRate(groups []Proportion) float64 {
+	if len(groups) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, group := range groups {
+		total += group.Rate()
+	}
+	return total / float64(len(groups))
+}
+
+// BootstrapMean returns a 95% percentile bootstrap interval for MeanRate. Each
+// resample draws every test's sample outcomes with replacement, so tests with
+// few samples contribute more uncertainty.
+func BootstrapMean(groups []Proportion) Interval {
+	rng := rand.New(rand.NewPCG(bootstrapSeed, uint64(len(groups))))
+	means := make([]float64, BootstrapIterations)
+	for i := range means {
+		means[i] = resampledMean(rng, groups)
+	}
+	return percentileInterval(means)
+}
+
+// BootstrapDifference returns a 95% percentile bootstrap interval for
+// MeanRate(a) - MeanRate(b). The difference is significant when the interval
+// excludes zero.
+func BootstrapDifference(a, b []Proportion) Interval {
+	rng := rand.New(rand.NewPCG(bootstrapSeed, uint64(len(a))<<32|uint64(len(b))))
+	differences := make([]float64, BootstrapIterations)
+	for i := range differences {
+		differences[i] = resampledMean(rng, a) - resampledMean(rng, b)
+	}
+	return percentileInterval(differences)
+}
+
+func resampledMean(rng *rand.Rand, groups []Proportion) float64 {
+	if len(groups) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, group := range groups {
+		if group.Total == 0 {
+			continue
+		}
+		correct := 0
+		for range group.Total {
+			if rng.IntN(group.Total) < group.Correct {
+				correct++
+			}
+		}
+		total += float64(correct) / float64(group.Total)
+	}
+	return total / float64(len(groups))
+}
+
+func percentileInterval(values []float64) Interval {
+	sort.Float64s(values)
+	low := values[int(math.Floor(0.025*float64(len(values)-1)))]
+	high := values[int(math.Ceil(0.975*float64(len(values)-1)))]
+	return Interval{Low: low, High: high}
+}
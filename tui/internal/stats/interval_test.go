@@ -0,0 +1,51 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWilsonMatchesReferenceValues(t *testing.T) {
+	cases := []struct {
+		correct, total int
+		low, high      float64
+	}{
+		{8, 10, 0.490160, 0.943318},
+		{0, 10, 0, 0.277533},
+		{10, 10, 0.722467, 1},
+		{45, 90, 0.398837, 0.601163},
+	}
+	for _, tc := range cases {
+		interval := Wilson(tc.correct, tc.total, Z95)
+		if math.Abs(interval.Low-tc.low) > 1e-5 || math.Abs(interval.High-tc.high) > 1e-5 {
+			t.Errorf("Wilson(%d, %d) = [%.6f, %.6f], want [%.6f, %.6f]",
+				tc.correct, tc.total, interval.Low, interval.High, tc.low, tc.high)
+		}
+	}
+	if interval := Wilson(0, 0, Z95); interval.Low != 0 || interval.High != 1 {
+		t.Errorf("expected the uninformative interval without samples, got %+v", interval)
+	}
+}
+
+func TestBootstrapIntervals(t *testing.T) {
+	perfect := []Proportion{{10, 10}, {10, 10}}
+	if interval := BootstrapMean(perfect); interval.Low != 1 || interval.High != 1 {
+		t.Fatalf("expected no uncertainty when every sample passed, got %+v", interval)
+	}
+
+	mixed := []Proportion{{7, 10}, {9, 10}, {4, 10}}
+	interval := BootstrapMean(mixed)
+	if !interval.Contains(MeanRate(mixed)) || interval.Low < 0.4 || interval.High > 0.9 {
+		t.Fatalf("unexpected interval %+v around %.3f", interval, MeanRate(mixed))
+	}
+	if again := BootstrapMean(mixed); again != interval {
+		t.Fatalf("bootstrap should be reproducible, got %+v then %+v", interval, again)
+	}
+
+	if difference := BootstrapDifference(mixed, []Proportion{{8, 10}, {8, 10}, {4, 10}}); !difference.Contains(0) {
+		t.Fatalf("a one-sample shuffle should not be significant, got %+v", difference)
+	}
+	if difference := BootstrapDifference(perfect, []Proportion{{1, 10}, {2, 10}}); difference.Contains(0) {
+		t.Fatalf("a large gap should be significant, got %+v", difference)
+	}
+}
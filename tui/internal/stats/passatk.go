@@ -0,0 +1,19 @@
+package stats
+
+// PassAtK is the unbiased pass@k estimator of the HumanEval paper: the
+// probability that at least one of k samples drawn without replacement from n
+// samples, c of them correct, passes. It is computed in the same product form
+// as calculatePassAtK in src/utils/humaneval.ts so both report the same
+// numbers:
+//
+//	pass@k = 1 - C(n-c, k) / C(n, k) = 1 - prod(1 - k/j) for j in n-c+1..n
+func PassAtK(n, c, k int) float64 {
+	if n-c < k {
+		return 1
+	}
+	result := 1.0
+	for j := n - c + 1; j <= n; j++ {
+		result *= 1 - float64(k)/float64(j)
+	}
+	return 1 - result
+}
@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestPassAtKMatchesHumanEvalValues(t *testing.T) {
+	cases := []struct {
+		n, c, k int
+		want    float64
+	}{
+		{10, 10, 5, 1},
+		{10, 0, 5, 0},
+		{10, 8, 3, 1},
+		{100, 20, 1, 0.2},
+		{10, 1, 10, 1},
+		{10, 0, 10, 0},
+		{5, 2, 1, 0.4},
+		{10, 3, 2, 1 - 21.0/45},
+	}
+	for _, tc := range cases {
+		if got := PassAtK(tc.n, tc.c, tc.k); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("PassAtK(%d, %d, %d) = %.12f, want %.12f", tc.n, tc.c, tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestPassAtKMatchesBinomialForm(t *testing.T) {
+	for n := 1; n <= 30; n++ {
+		for c := 0; c <= n; c++ {
+			for k := 1; k <= n; k++ {
+				ratio := new(big.Rat).SetFrac(
+					new(big.Int).Binomial(int64(n-c), int64(k)),
+					new(big.Int).Binomial(int64(n), int64(k)))
+				exact, _ := new(big.Rat).Sub(big.NewRat(1, 1), ratio).Float64()
+				if got := PassAtK(n, c, k); math.Abs(got-exact) > 1e-12 {
+					t.Fatalf("PassAtK(%d, %d, %d) = %.15f, want %.15f", n, c, k, got, exact)
+				}
+			}
+		}
+	}
+}
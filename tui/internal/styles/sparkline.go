@@ -0,0 +1,34 @@
+package styles
+
+import (
+	"math"
+	"strings"
+)
+
+// SparklineLevels are the block characters of a sparkline, lowest first.
+var SparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// SparklineGap marks a point without a value, such as a run that skipped a
+// test.
+const SparklineGap = '·'
+
+// Sparkline renders values as one character each, scaled between low and high.
+// NaN values render as SparklineGap. Values outside the range are clamped, so
+// charts drawn with the same range can be compared by eye.
+func Sparkline(values []float64, low, high float64) string {
+	var b strings.Builder
+	span := high - low
+	for _, value := range values {
+		if math.IsNaN(value) {
+			b.WriteRune(SparklineGap)
+			continue
+		}
+		level := 0
+		if span > 0 {
+			position := (math.Min(math.Max(value, low), high) - low) / span
+			level = int(math.Round(position * float64(len(SparklineLevels)-1)))
+		}
+		b.WriteRune(SparklineLevels[level])
+	}
+	return b.String()
+}
@@ -0,0 +1,175 @@
+package styles
+
+import (
+	"strings"
+	"unicode"
+
+	"charm.land/lipgloss/v2"
+)
+
+// Syntax colors for generated Svelte components
+var (
+	SyntaxKeywordStyle = lipgloss.NewStyle().Foreground(OrangePrimary)
+	SyntaxRuneStyle    = lipgloss.NewStyle().Foreground(OrangeLight).Bold(true)
+	SyntaxStringStyle  = lipgloss.NewStyle().Foreground(OrangeSuccess)
+	SyntaxNumberStyle  = lipgloss.NewStyle().Foreground(OrangeMid)
+	SyntaxCommentStyle = lipgloss.NewStyle().Foreground(GrayDim).Italic(true)
+	SyntaxTagStyle     = lipgloss.NewStyle().Foreground(OrangeMid)
+	SyntaxPlainStyle   = lipgloss.NewStyle().Foreground(GrayLight)
+)
+
+var syntaxKeywords = map[string]bool{
+	"as": true, "async": true, "await": true, "break": true, "case": true, "catch": true,
+	"class": true, "const": true, "continue": true, "default": true, "else": true,
+	"export": true, "extends": true, "false": true, "finally": true, "for": true,
+	"from": true, "function": true, "if": true, "import": true, "in": true, "let": true,
+	"new": true, "null": true, "of": true, "return": true, "switch": true, "this": true,
+	"throw": true, "true": true, "try": true, "typeof": true, "undefined": true,
+	"var": true, "while": true,
+}
+
+// syntaxState carries constructs that span lines between calls.
+type syntaxState int
+
+const (
+	syntaxCode syntaxState = iota
+	syntaxBlockComment
+	syntaxHTMLComment
+	syntaxTemplate
+)
+
+// HighlightSvelte colors Svelte component source line by line. It is a small
+// lexer for keywords, runes, strings, comments, numbers and markup tags, not a
+// full parser, so unusual code still renders, just with fewer colors.
+func HighlightSvelte(code string) []string {
+	source := strings.Split(strings.ReplaceAll(code, "\t", "  "), "\n")
+	lines := make([]string, len(source))
+	state := syntaxCode
+	for i, line := range source {
+		lines[i], state = highlightLine(line, state)
+	}
+	return lines
+}
+
+func highlightLine(line string, state syntaxState) (string, syntaxState) {
+	var out strings.Builder
+	runes := []rune(line)
+	i := 0
+
+	// closeSpan consumes up to and including end, or the rest of the line.
+	closeSpan := func(end string, style lipgloss.Style) bool {
+		rest := string(runes[i:])
+		idx := strings.Index(rest, end)
+		if idx < 0 {
+			out.WriteString(style.Render(rest))
+			i = len(runes)
+			return false
+		}
+		span := rest[:idx+len(end)]
+		out.WriteString(style.Render(span))
+		i += len([]rune(span))
+		return true
+	}
+
+	for i < len(runes) {
+		switch state {
+		case syntaxBlockComment:
+			if closeSpan("*/", SyntaxCommentStyle) {
+				state = syntaxCode
+			}
+			continue
+		case syntaxHTMLComment:
+			if closeSpan("-->", SyntaxCommentStyle) {
+				state = syntaxCode
+			}
+			continue
+		case syntaxTemplate:
+			if closeSpan("`", SyntaxStringStyle) {
+				state = syntaxCode
+			}
+			continue
+		}
+
+		rest := string(runes[i:])
+		r := runes[i]
+		switch {
+		case strings.HasPrefix(rest, "//"):
+			out.WriteString(SyntaxCommentStyle.Render(rest))
+			i = len(runes)
+		case strings.HasPrefix(rest, "/*"):
+			state = syntaxBlockComment
+		case strings.HasPrefix(rest, "<!--"):
+			state = syntaxHTMLComment
+		case r == '`':
+			out.WriteString(SyntaxStringStyle.Render("`"))
+			i++
+			state = syntaxTemplate
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end+1, len(runes))
+			out.WriteString(SyntaxStringStyle.Render(string(runes[i:end])))
+			i = end
+		case r == '<' && i+1 < len(runes) && (runes[i+1] == '/' || unicode.IsLetter(runes[i+1])):
+			end := i + 1
+			if runes[end] == '/' {
+				end++
+			}
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '-' || runes[end] == ':' || runes[end] == '.') {
+				end++
+			}
+			out.WriteString(SyntaxTagStyle.Render(string(runes[i:end])))
+			i = end
+		case r == '{' && i+1 < len(runes) && strings.ContainsRune("#/:@", runes[i+1]):
+			// Svelte block syntax such as {#each}, {:else}, {/if} and {@html}.
+			end := i + 2
+			for end < len(runes) && unicode.IsLetter(runes[end]) {
+				end++
+			}
+			out.WriteString(SyntaxKeywordStyle.Render(string(runes[i:end])))
+			i = end
+		case unicode.IsDigit(r):
+			end := i
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			out.WriteString(SyntaxNumberStyle.Render(string(runes[i:end])))
+			i = end
+		case r == '$' || r == '_' || unicode.IsLetter(r):
+			end := i + 1
+			for end < len(runes) && (runes[end] == '$' || runes[end] == '_' || unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end])) {
+				end++
+			}
+			word := string(runes[i:end])
+			switch {
+			case strings.HasPrefix(word, "$") && len(word) > 1:
+				out.WriteString(SyntaxRuneStyle.Render(word))
+			case syntaxKeywords[word]:
+				out.WriteString(SyntaxKeywordStyle.Render(word))
+			default:
+				out.WriteString(SyntaxPlainStyle.Render(word))
+			}
+			i = end
+		default:
+			end := i + 1
+			for end < len(runes) && !startsToken(runes, end) {
+				end++
+			}
+			out.WriteString(SyntaxPlainStyle.Render(string(runes[i:end])))
+			i = end
+		}
+	}
+	return out.String(), state
+}
+
+// startsToken reports whether a highlighted token may begin at runes[i].
+func startsToken(runes []rune, i int) bool {
+	r := runes[i]
+	return r == '/' || r == '<' || r == '{' || r == '`' || r == '"' || r == '\'' ||
+		r == '$' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}